@@ -0,0 +1,358 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+type applyFunc struct {
+	TypeName         string
+	FuncName         string
+	MaskTypeName     string
+	ApplyAllFuncName string
+	QualifiedType    string
+
+	FieldStmts []string
+	FieldImpls []fieldApplyImpl
+	SubImpls   []subApplyImpl
+}
+
+type fieldApplyImpl struct {
+	QualifiedType string
+	FuncName      string
+	Body          string
+}
+
+type subApplyImpl struct {
+	QualifiedType string
+	FuncName      string
+	MaskTypeName  string
+	Body          string
+}
+
+func getApplyFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_Apply", e.alias, e.typeName)
+}
+
+func getApplyAllFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_ApplyAll", e.alias, e.typeName)
+}
+
+func getFieldApplyFuncName(obj *objectInfo, field objectField) string {
+	return fmt.Sprintf("%s_%s_Apply_%s", obj.alias, obj.typeName, field.name)
+}
+
+func getSubApplyFuncName(obj *objectInfo, field objectField) string {
+	return fmt.Sprintf("%s_%s_ApplySub_%s", obj.alias, obj.typeName, field.name)
+}
+
+func applyBodyForOneof(obj *objectInfo, field objectField) string {
+	wrapperType := getOneofWrapperTypeName(obj, field.oneof)
+	oneofName := field.oneof.fieldName
+
+	result := fmt.Sprintf(`
+wrapper, ok := src.%s.(*%s)
+if !ok {
+	if _, ok := dst.%s.(*%s); ok {
+		dst.%s = nil
+	}
+	return
+}
+`,
+		oneofName, wrapperType,
+		oneofName, wrapperType,
+		oneofName,
+	)
+	if field.fieldType != fieldTypeObject {
+		result += fmt.Sprintf("dst.%s = &%s{%s: wrapper.%s}\n",
+			oneofName, wrapperType, field.name, field.name,
+		)
+		return strings.TrimSpace(result)
+	}
+
+	result += fmt.Sprintf(`if !opts.MergeMessages || wrapper.%s == nil {
+	dst.%s = &%s{%s: wrapper.%s}
+	return
+}
+dstWrapper, ok := dst.%s.(*%s)
+if !ok || dstWrapper.%s == nil {
+	dstWrapper = &%s{%s: &%s{}}
+	dst.%s = dstWrapper
+}
+%s(dstWrapper.%s, wrapper.%s, opts)
+`,
+		field.name,
+		oneofName, wrapperType, field.name, field.name,
+		oneofName, wrapperType,
+		field.name,
+		wrapperType, field.name, getQualifiedTypeName(field.info),
+		oneofName,
+		getApplyAllFuncName(field.info), field.name, field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func applyBodyForObject(field objectField) string {
+	result := fmt.Sprintf(`
+if !opts.MergeMessages || src.%s == nil {
+	dst.%s = src.%s
+	return
+}
+if dst.%s == nil {
+	dst.%s = &%s{}
+}
+%s(dst.%s, src.%s, opts)
+`,
+		field.name,
+		field.name, field.name,
+		field.name,
+		field.name, getQualifiedTypeName(field.info),
+		getApplyAllFuncName(field.info), field.name, field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func applyBodyForRepeated(field objectField, appendExpr string) string {
+	result := fmt.Sprintf(`
+if opts.AppendRepeated {
+	dst.%s = %s
+	return
+}
+dst.%s = src.%s
+`,
+		field.name, appendExpr,
+		field.name, field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildFieldApplyBody(obj *objectInfo, field objectField) string {
+	switch {
+	case field.oneof != nil:
+		return applyBodyForOneof(obj, field)
+
+	case field.fieldType == fieldTypeObject:
+		return applyBodyForObject(field)
+
+	case field.container == containerTypeList:
+		return applyBodyForRepeated(field, fmt.Sprintf("append(dst.%s, src.%s...)", field.name, field.name))
+
+	case field.container == containerTypeMap:
+		return applyBodyForRepeated(field, fmt.Sprintf("fields.MergeMap(dst.%s, src.%s)", field.name, field.name))
+
+	default:
+		return fmt.Sprintf("dst.%s = src.%s", field.name, field.name)
+	}
+}
+
+func subApplyBodyForObject(field objectField) string {
+	subObjectType := getQualifiedTypeName(field.info)
+
+	result := fmt.Sprintf(`
+if dst.%s == nil && src.%s == nil {
+	return
+}
+if dst.%s == nil {
+	dst.%s = &%s{}
+}
+srcSubMsg := src.%s
+if srcSubMsg == nil {
+	srcSubMsg = &%s{}
+}
+%s(m, dst.%s, srcSubMsg, opts)
+`,
+		field.name, field.name,
+		field.name,
+		field.name, subObjectType,
+		field.name,
+		subObjectType,
+		getApplyFuncName(field.info), field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func subApplyBodyForOneofObject(obj *objectInfo, field objectField) string {
+	subObjectType := getQualifiedTypeName(field.info)
+	wrapperType := getOneofWrapperTypeName(obj, field.oneof)
+	oneofName := field.oneof.fieldName
+
+	result := fmt.Sprintf(`
+wrapper, ok := src.%s.(*%s)
+if !ok {
+	if _, ok := dst.%s.(*%s); ok {
+		dst.%s = nil
+	}
+	return
+}
+dstWrapper, ok := dst.%s.(*%s)
+if !ok || dstWrapper.%s == nil {
+	dstWrapper = &%s{%s: &%s{}}
+	dst.%s = dstWrapper
+}
+srcSubMsg := wrapper.%s
+if srcSubMsg == nil {
+	srcSubMsg = &%s{}
+}
+%s(m, dstWrapper.%s, srcSubMsg, opts)
+`,
+		oneofName, wrapperType,
+		oneofName, wrapperType,
+		oneofName,
+		oneofName, wrapperType,
+		field.name,
+		wrapperType, field.name, subObjectType,
+		oneofName,
+		field.name,
+		subObjectType,
+		getApplyFuncName(field.info), field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func subApplyBodyForRepeatedObjects(field objectField) string {
+	subObjectType := getQualifiedTypeName(field.info)
+	keepFuncName := getKeepFuncName(field.info)
+
+	var buildStmt string
+	var appendExpr string
+	var newValue string
+
+	if field.fieldType == fieldTypeMapOfObjects {
+		buildStmt = fmt.Sprintf(`
+msgMap := make(map[%s]*%s, len(src.%s))
+for k, e := range src.%s {
+	if e == nil {
+		msgMap[k] = nil
+		continue
+	}
+	newSubMsg := &%s{}
+	%s(m, newSubMsg, e)
+	msgMap[k] = newSubMsg
+}
+`,
+			field.mapKeyType, subObjectType, field.name,
+			field.name,
+			subObjectType,
+			keepFuncName,
+		)
+		appendExpr = fmt.Sprintf("fields.MergeMap(dst.%s, msgMap)", field.name)
+		newValue = "msgMap"
+	} else {
+		buildStmt = fmt.Sprintf(`
+msgList := make([]*%s, 0, len(src.%s))
+for _, e := range src.%s {
+	if e == nil {
+		msgList = append(msgList, nil)
+		continue
+	}
+	newSubMsg := &%s{}
+	%s(m, newSubMsg, e)
+	msgList = append(msgList, newSubMsg)
+}
+`,
+			subObjectType, field.name,
+			field.name,
+			subObjectType,
+			keepFuncName,
+		)
+		appendExpr = fmt.Sprintf("append(dst.%s, msgList...)", field.name)
+		newValue = "msgList"
+	}
+
+	result := fmt.Sprintf(`
+%s
+if opts.AppendRepeated {
+	dst.%s = %s
+	return
+}
+dst.%s = %s
+`,
+		strings.TrimSpace(buildStmt),
+		field.name, appendExpr,
+		field.name, newValue,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildSubApplyImpl(obj *objectInfo, field objectField) subApplyImpl {
+	var body string
+	switch {
+	case field.fieldType == fieldTypeObject && field.oneof != nil:
+		body = subApplyBodyForOneofObject(obj, field)
+
+	case field.fieldType == fieldTypeObject:
+		body = subApplyBodyForObject(field)
+
+	default:
+		body = subApplyBodyForRepeatedObjects(field)
+	}
+
+	return subApplyImpl{
+		QualifiedType: getQualifiedTypeName(obj),
+		FuncName:      getSubApplyFuncName(obj, field),
+		MaskTypeName:  getMaskTypeName(field.info),
+		Body:          body,
+	}
+}
+
+func buildApplyStmtForField(obj *objectInfo, index int, field objectField) string {
+	stmt := fmt.Sprintf("%s(dst, src, opts)", getFieldApplyFuncName(obj, field))
+	if field.fieldType == fieldTypeStruct {
+		stmt = fmt.Sprintf(`
+if m.%s == nil {
+	%s
+} else {
+	%s
+}
+`,
+			field.name,
+			stmt,
+			applyKeysStmtForStruct(field),
+		)
+		stmt = strings.TrimSpace(stmt)
+	}
+	if field.info != nil {
+		stmt = fmt.Sprintf(`
+if m.%s == nil {
+	%s
+} else {
+	%s(m.%s, dst, src, opts)
+}
+`,
+			field.name,
+			stmt,
+			getSubApplyFuncName(obj, field), field.name,
+		)
+		stmt = strings.TrimSpace(stmt)
+	}
+	return fmt.Sprintf("if %s {\n%s\n}", getBitExpr(index), stmt)
+}
+
+func buildApplyFunc(info *objectInfo) applyFunc {
+	fieldStmts := make([]string, 0, len(info.subFields))
+	var subImpls []subApplyImpl
+	for index, subField := range info.subFields {
+		fieldStmts = append(fieldStmts, buildApplyStmtForField(info, index, subField))
+		if subField.info != nil {
+			subImpls = append(subImpls, buildSubApplyImpl(info, subField))
+		}
+	}
+
+	return applyFunc{
+		TypeName:         info.typeName,
+		FuncName:         getApplyFuncName(info),
+		MaskTypeName:     getMaskTypeName(info),
+		ApplyAllFuncName: getApplyAllFuncName(info),
+		QualifiedType:    getQualifiedTypeName(info),
+
+		FieldStmts: fieldStmts,
+		FieldImpls: mapSlice(info.subFields, func(subField objectField) fieldApplyImpl {
+			return fieldApplyImpl{
+				QualifiedType: getQualifiedTypeName(info),
+				FuncName:      getFieldApplyFuncName(info, subField),
+				Body:          buildFieldApplyBody(info, subField),
+			}
+		}),
+		SubImpls: subImpls,
+	}
+}
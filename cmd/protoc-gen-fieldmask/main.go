@@ -0,0 +1,25 @@
+package main
+
+import (
+	"flag"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/QuangTung97/fieldmask"
+)
+
+func main() {
+	var flags flag.FlagSet
+	wellKnownTypes := flags.Bool(
+		"well_known_types", false,
+		"allow sub fields of Timestamp, Duration and the wrapper types, and keys of Struct, Value and ListValue",
+	)
+
+	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+		var options []fieldmask.GenerateOption
+		if *wellKnownTypes {
+			options = append(options, fieldmask.WithWellKnownTypes())
+		}
+		return fieldmask.GeneratePlugin(gen, options...)
+	})
+}
@@ -0,0 +1,260 @@
+// Package dynamicmask masks any proto message at runtime through protoreflect, without code generation.
+// It produces the same result as the Mask method of the generated field masks, but is slower.
+package dynamicmask
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+const wellKnownTypesPrefix = "google.protobuf."
+
+// Mask is a field mask of a message descriptor
+type Mask struct {
+	desc         protoreflect.MessageDescriptor
+	root         *maskNode // nil means all fields
+	maskedFields []fields.FieldInfo
+}
+
+type maskNode struct {
+	fields []maskField
+}
+
+type maskField struct {
+	desc protoreflect.FieldDescriptor
+	sub  *maskNode // nil means all fields of a message field
+}
+
+// New creates a field mask from the list of field paths, with the same syntax and options as the generated code
+func New(desc protoreflect.MessageDescriptor, maskedFields []string, options ...fields.Option) (*Mask, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	style := fields.ComputeNameStyle(options...)
+	if style != fields.NameStyleJSON {
+		return NewFromFieldInfos(desc, fieldInfos, options...)
+	}
+
+	// the JSON names are already validated against the limited to fields by fields.ComputeFieldInfos
+	normalized, err := normalizeFields(desc, fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	return newMask(desc, normalized), nil
+}
+
+// NewFromFieldInfos validates the field infos against the message descriptor and creates a field mask
+func NewFromFieldInfos(
+	desc protoreflect.MessageDescriptor, fieldInfos []fields.FieldInfo, options ...fields.Option,
+) (*Mask, error) {
+	style := fields.ComputeNameStyle(options...)
+
+	normalized, err := normalizeFields(desc, fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fields.ValidateLimitedToFields(normalized, options...); err != nil {
+		return nil, err
+	}
+
+	return newMask(desc, normalized), nil
+}
+
+func newMask(desc protoreflect.MessageDescriptor, normalized []fields.FieldInfo) *Mask {
+	return &Mask{
+		desc:         desc,
+		root:         compileNode(desc, normalized),
+		maskedFields: normalized,
+	}
+}
+
+func isSpecialMessage(desc protoreflect.MessageDescriptor) bool {
+	return strings.HasPrefix(string(desc.FullName()), wellKnownTypesPrefix)
+}
+
+// getSubMessage returns the message descriptor that the sub fields of the field refer to, nil if not allowed
+func getSubMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	msg := fd.Message()
+	if msg == nil || isSpecialMessage(msg) {
+		return nil
+	}
+	return msg
+}
+
+func getAllFields(desc protoreflect.MessageDescriptor) []fields.FieldInfo {
+	fieldList := desc.Fields()
+	result := make([]fields.FieldInfo, 0, fieldList.Len())
+	for i := 0; i < fieldList.Len(); i++ {
+		result = append(result, fields.FieldInfo{FieldName: fieldList.Get(i).JSONName()})
+	}
+	return result
+}
+
+func findField(
+	desc protoreflect.MessageDescriptor, name string, style fields.NameStyle,
+) (protoreflect.FieldDescriptor, bool) {
+	fieldList := desc.Fields()
+	for i := 0; i < fieldList.Len(); i++ {
+		fd := fieldList.Get(i)
+		if style.Match(name, fd.JSONName(), string(fd.Name())) {
+			return fd, true
+		}
+	}
+	return nil, false
+}
+
+func normalizeField(
+	desc protoreflect.MessageDescriptor, field fields.FieldInfo, style fields.NameStyle,
+) (fields.FieldInfo, error) {
+	fd, ok := findField(desc, field.FieldName, style)
+	if !ok {
+		return fields.FieldInfo{}, fields.ErrFieldNotFound(field.FieldName)
+	}
+
+	subFields := field.SubFields
+	if len(subFields) > 0 {
+		subMsg := getSubMessage(fd)
+		if subMsg == nil {
+			err := fields.ErrFieldNotFound(subFields[0].FieldName)
+			return fields.FieldInfo{}, fields.PrependParentField(err, field.FieldName)
+		}
+
+		var err error
+		subFields, err = normalizeFields(subMsg, subFields, style)
+		if err != nil {
+			return fields.FieldInfo{}, fields.PrependParentField(err, field.FieldName)
+		}
+	}
+	return fields.FieldInfo{FieldName: fd.JSONName(), SubFields: subFields}, nil
+}
+
+// normalizeFields validates the field infos against the descriptor and converts field names to the JSON names
+func normalizeFields(
+	desc protoreflect.MessageDescriptor, fieldInfos []fields.FieldInfo, style fields.NameStyle,
+) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		if field.FieldName != fields.Wildcard {
+			var err error
+			field, err = normalizeField(desc, field, style)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// compileNode builds the mask node from the normalized field infos
+func compileNode(desc protoreflect.MessageDescriptor, fieldInfos []fields.FieldInfo) *maskNode {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, getAllFields(desc))
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+
+	node := &maskNode{fields: make([]maskField, 0, len(fieldInfos))}
+	for _, field := range fieldInfos {
+		fd := desc.Fields().ByJSONName(field.FieldName)
+
+		var sub *maskNode
+		if len(field.SubFields) > 0 {
+			sub = compileNode(getSubMessage(fd), field.SubFields)
+		}
+		node.fields = append(node.fields, maskField{desc: fd, sub: sub})
+	}
+	return node
+}
+
+// Mask returns a new message containing only the fields in the field mask.
+// Messages and lists not traversed by the field mask are shared with the input message.
+// Panics if msg is not a message of the descriptor of the field mask
+func (m *Mask) Mask(msg proto.Message) proto.Message {
+	src := msg.ProtoReflect()
+	if name := src.Descriptor().FullName(); name != m.desc.FullName() {
+		panic(fmt.Sprintf("field mask of message %q can not mask message %q", m.desc.FullName(), name))
+	}
+	dst := src.New()
+	keepFields(m.root, dst, src)
+	return dst.Interface()
+}
+
+// GetMaskedFields returns the field infos, with field names normalized to the JSON names
+func (m *Mask) GetMaskedFields() []fields.FieldInfo {
+	return m.maskedFields
+}
+
+// Descriptor returns the message descriptor of the field mask
+func (m *Mask) Descriptor() protoreflect.MessageDescriptor {
+	return m.desc
+}
+
+func keepFields(node *maskNode, dst protoreflect.Message, src protoreflect.Message) {
+	if node == nil {
+		src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			dst.Set(fd, v)
+			return true
+		})
+		dst.SetUnknown(src.GetUnknown())
+		return
+	}
+
+	for _, field := range node.fields {
+		if !src.Has(field.desc) {
+			continue
+		}
+		value := src.Get(field.desc)
+
+		switch {
+		case field.sub == nil:
+			dst.Set(field.desc, value)
+
+		case field.desc.IsList():
+			keepList(field.sub, dst.Mutable(field.desc).List(), value.List())
+
+		case field.desc.IsMap():
+			keepMap(field.sub, dst.Mutable(field.desc).Map(), value.Map())
+
+		default:
+			keepFields(field.sub, dst.Mutable(field.desc).Message(), value.Message())
+		}
+	}
+}
+
+func keepList(node *maskNode, dst protoreflect.List, src protoreflect.List) {
+	for i := 0; i < src.Len(); i++ {
+		newElem := dst.NewElement()
+		keepFields(node, newElem.Message(), src.Get(i).Message())
+		dst.Append(newElem)
+	}
+}
+
+func keepMap(node *maskNode, dst protoreflect.Map, src protoreflect.Map) {
+	src.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		newValue := dst.NewValue()
+		keepFields(node, newValue.Message(), v.Message())
+		dst.Set(k, newValue)
+		return true
+	})
+}
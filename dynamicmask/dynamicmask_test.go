@@ -0,0 +1,296 @@
+package dynamicmask
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/generated"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+func findMessageDescriptor(t *testing.T, name protoreflect.FullName) protoreflect.MessageDescriptor {
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return desc.(protoreflect.MessageDescriptor)
+}
+
+type gogoMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+func toDynamic(t *testing.T, desc protoreflect.MessageDescriptor, msg gogoMarshaler) proto.Message {
+	data, err := msg.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func fromDynamic(t *testing.T, msg proto.Message, result interface{ Unmarshal([]byte) error }) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := result.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// normalizeProduct round trips the message through the wire format, because it can not distinguish nil and empty slices
+func normalizeProduct(t *testing.T, msg *pb.Product) *pb.Product {
+	data, err := msg.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := &pb.Product{}
+	if err := result.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func newProduct() *pb.Product {
+	return &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			Logo:     "Provider Logo",
+			ImageUrl: "provider-image-url",
+		},
+		Attributes: []*pb.Attribute{
+			{
+				Id:   31,
+				Code: "ATTR01",
+				Name: "Attr Name 01",
+				Options: []*pb.Option{
+					{Code: "OPTION01", Name: "Option Name 01"},
+					{Code: "OPTION02", Name: "Option Name 02"},
+				},
+			},
+			{
+				Id:   32,
+				Code: "ATTR02",
+				Name: "Attr Name 02",
+			},
+		},
+		SellerIds:  []int32{51, 52},
+		BrandCodes: []string{"BRAND01", "BRAND02"},
+		CreatedAt:  &types.Timestamp{Seconds: 1000, Nanos: 20},
+		Quantity:   &types.DoubleValue{Value: 886},
+		Stocks:     []*types.Int32Value{{Value: 228}},
+	}
+}
+
+func TestMask_Product_Same_As_Generated(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Product")
+	product := newProduct()
+
+	table := []struct {
+		name    string
+		paths   []string
+		options []fields.Option
+	}{
+		{name: "empty"},
+		{name: "only sku", paths: []string{"sku"}},
+		{name: "provider", paths: []string{"sku", "provider"}},
+		{name: "provider sub fields", paths: []string{"provider.{id|imageUrl}", "sellerIds"}},
+		{name: "attributes", paths: []string{"attributes.{code|options.name}", "brandCodes"}},
+		{name: "well-known types", paths: []string{"createdAt", "quantity", "stocks"}},
+		{name: "wildcard", paths: []string{"provider.*", "attributes.{id|options.*}"}},
+		{name: "wildcard at root", paths: []string{"*", "provider.name"}},
+		{
+			name:    "proto names",
+			paths:   []string{"provider.image_url", "seller_ids"},
+			options: []fields.Option{fields.WithNameStyle(fields.NameStyleProto)},
+		},
+		{
+			name:    "both names",
+			paths:   []string{"provider.{image_url|name}", "sellerIds"},
+			options: []fields.Option{fields.WithNameStyle(fields.NameStyleBoth)},
+		},
+	}
+
+	for _, e := range table {
+		t.Run(e.name, func(t *testing.T) {
+			fm, err := generated.NewProductFieldMask(e.paths, e.options...)
+			assert.Equal(t, nil, err)
+
+			dm, err := New(desc, e.paths, e.options...)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, fm.GetMaskedFields(), dm.GetMaskedFields())
+
+			result := &pb.Product{}
+			fromDynamic(t, dm.Mask(toDynamic(t, desc, product)), result)
+			assert.Equal(t, normalizeProduct(t, fm.Mask(product)), result)
+		})
+	}
+}
+
+func TestMask_Catalog_Maps(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Catalog")
+	catalog := &pb.Catalog{
+		Code: "CATALOG01",
+		AttributesByCode: map[string]*pb.Attribute{
+			"ATTR01": {Id: 31, Code: "ATTR01", Name: "Attr Name 01"},
+		},
+		Providers: map[int32]*pb.ProviderInfo{
+			21: {Id: 21, Name: "Provider Name", Logo: "Provider Logo"},
+		},
+		Labels: map[string]string{"key": "value"},
+	}
+
+	paths := []string{"attributesByCode.{id|name}", "providers.logo", "labels"}
+
+	fm, err := generated.NewCatalogFieldMask(paths)
+	assert.Equal(t, nil, err)
+
+	dm, err := New(desc, paths)
+	assert.Equal(t, nil, err)
+
+	result := &pb.Catalog{}
+	fromDynamic(t, dm.Mask(toDynamic(t, desc, catalog)), result)
+	assert.Equal(t, fm.Mask(catalog), result)
+	assert.Equal(t, &pb.Catalog{
+		AttributesByCode: map[string]*pb.Attribute{
+			"ATTR01": {Id: 31, Name: "Attr Name 01"},
+		},
+		Providers: map[int32]*pb.ProviderInfo{
+			21: {Logo: "Provider Logo"},
+		},
+		Labels: map[string]string{"key": "value"},
+	}, result)
+}
+
+func TestMask_Oneof(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Item")
+	item := &pb.Item{
+		Id: "ITEM01",
+		Payload: &pb.Item_Book{
+			Book: &pb.Book{Isbn: "ISBN01", Title: "Title 01"},
+		},
+		Quantity: 5,
+	}
+
+	dm, err := New(desc, []string{"book.title", "quantity"})
+	assert.Equal(t, nil, err)
+
+	result := &pb.Item{}
+	fromDynamic(t, dm.Mask(toDynamic(t, desc, item)), result)
+	assert.Equal(t, &pb.Item{
+		Payload: &pb.Item_Book{
+			Book: &pb.Book{Title: "Title 01"},
+		},
+		Quantity: 5,
+	}, result)
+}
+
+func TestMask_Other_Message(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Product")
+	dm, err := New(desc, []string{"sku"})
+	assert.Equal(t, nil, err)
+
+	catalog := toDynamic(t, findMessageDescriptor(t, "testdata.v1.Catalog"), &pb.Catalog{Code: "CATALOG01"})
+	assert.PanicsWithValue(t,
+		`field mask of message "testdata.v1.Product" can not mask message "testdata.v1.Catalog"`,
+		func() { dm.Mask(catalog) },
+	)
+}
+
+func TestNew_Errors(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Product")
+
+	t.Run("field not found", func(t *testing.T) {
+		dm, err := New(desc, []string{"sku", "provider.imageURL"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("imageURL"), "provider"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("sub field of simple field", func(t *testing.T) {
+		dm, err := New(desc, []string{"sku.name"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("name"), "sku"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("sub field of well-known type", func(t *testing.T) {
+		dm, err := New(desc, []string{"createdAt.seconds"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("seconds"), "createdAt"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("duplicated with both names", func(t *testing.T) {
+		dm, err := New(desc, []string{"sellerIds", "seller_ids"}, fields.WithNameStyle(fields.NameStyleBoth))
+		assert.Equal(t, fields.ErrDuplicatedField("sellerIds"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("proto name not allowed by default", func(t *testing.T) {
+		dm, err := New(desc, []string{"seller_ids"})
+		assert.Equal(t, fields.ErrFieldNotFound("seller_ids"), err)
+		assert.Nil(t, dm)
+	})
+}
+
+func TestNew_LimitedToFields(t *testing.T) {
+	desc := findMessageDescriptor(t, "testdata.v1.Product")
+	limitedTo := fields.WithLimitedToFields([]string{"sku", "provider.{id|imageUrl}"})
+
+	t.Run("field infos with json names", func(t *testing.T) {
+		dm, err := NewFromFieldInfos(desc, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "sellerIds"},
+		}, limitedTo)
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("field infos with proto names", func(t *testing.T) {
+		dm, err := NewFromFieldInfos(desc, []fields.FieldInfo{
+			{FieldName: "provider", SubFields: []fields.FieldInfo{{FieldName: "name"}}},
+		}, limitedTo, fields.WithNameStyle(fields.NameStyleProto))
+		assert.Equal(t, fields.ErrFieldNotFound("provider.name"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("paths with json names", func(t *testing.T) {
+		dm, err := New(desc, []string{"sku", "provider.name"}, limitedTo)
+		assert.Equal(t, fields.ErrFieldNotFound("provider.name"), err)
+		assert.Nil(t, dm)
+
+		dm, err = New(desc, []string{"sku", "provider.imageUrl"}, limitedTo)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "provider", SubFields: []fields.FieldInfo{{FieldName: "imageUrl"}}},
+		}, dm.GetMaskedFields())
+	})
+
+	t.Run("paths with proto names", func(t *testing.T) {
+		dm, err := New(desc, []string{"seller_ids"}, limitedTo, fields.WithNameStyle(fields.NameStyleProto))
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, dm)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		dm, err := NewFromFieldInfos(desc, []fields.FieldInfo{
+			{FieldName: "provider", SubFields: []fields.FieldInfo{{FieldName: "image_url"}}},
+		}, limitedTo, fields.WithNameStyle(fields.NameStyleBoth))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "provider", SubFields: []fields.FieldInfo{{FieldName: "imageUrl"}}},
+		}, dm.GetMaskedFields())
+	})
+}
@@ -0,0 +1,79 @@
+package fieldmask
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidMessageType is returned when a proto message is nil or not a pointer to a struct
+	ErrInvalidMessageType = errors.New("invalid message type")
+
+	// ErrSpecialMessageType is returned when a proto message is of a well-known types package
+	ErrSpecialMessageType = errors.New("message type of a special package is not allowed")
+
+	// ErrLimitedToFieldNotFound is returned when a limited to field does not exist in the message
+	ErrLimitedToFieldNotFound = errors.New("limited to field not found")
+
+	// ErrRecursiveFieldMap is returned when generating a field map of a recursive message type
+	ErrRecursiveFieldMap = errors.New("field map of a recursive message type is not supported")
+)
+
+// GenerateError is an error of a message, or of a field path of a message when FieldPath is not empty
+type GenerateError struct {
+	MessageName string
+	FieldPath   string
+	Err         error
+}
+
+func (e *GenerateError) Error() string {
+	if e.FieldPath == "" {
+		return fmt.Sprintf("fieldmask: message '%s': %v", e.MessageName, e.Err)
+	}
+	return fmt.Sprintf("fieldmask: message '%s', field '%s': %v", e.MessageName, e.FieldPath, e.Err)
+}
+
+func (e *GenerateError) Unwrap() error {
+	return e.Err
+}
+
+// GenerateErrors is the list of all errors found in the input messages
+type GenerateErrors []*GenerateError
+
+func (e GenerateErrors) Error() string {
+	return strings.Join(mapSlice([]*GenerateError(e), func(err *GenerateError) string {
+		return err.Error()
+	}), "\n")
+}
+
+// Unwrap returns the errors of the list, for errors.Is and errors.As since Go 1.20
+func (e GenerateErrors) Unwrap() []error {
+	return mapSlice([]*GenerateError(e), func(err *GenerateError) error {
+		return err
+	})
+}
+
+// Is checks whether any error of the list matches target, for errors.Is before Go 1.20
+func (e GenerateErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatError is returned when the generated code can not be formatted, Source is the unformatted code
+type FormatError struct {
+	Source []byte
+	Err    error
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("fieldmask: format generated code: %v", e.Err)
+}
+
+func (e *FormatError) Unwrap() error {
+	return e.Err
+}
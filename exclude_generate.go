@@ -0,0 +1,84 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+type excludeCase struct {
+	CaseNames string
+	JSONName  string
+}
+
+type excludeFunc struct {
+	FuncName         string
+	AllFieldsVarName string
+	FieldCount       int
+	Cases            []excludeCase
+	FieldStmts       []string
+}
+
+func getComputeIncludedFieldsFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_ComputeIncludedFields", e.alias, e.typeName)
+}
+
+func includeStmtForSimpleField(field objectField) string {
+	result := fmt.Sprintf(`
+if subFields, ok := excluded["%s"]; !ok {
+	result = append(result, fields.FieldInfo{FieldName: "%s"})
+} else if len(subFields) > 0 {
+	return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "%s")
+}
+`,
+		field.jsonName,
+		field.jsonName,
+		field.jsonName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func includeStmtForObjectField(field objectField) string {
+	result := fmt.Sprintf(`
+if subFields, ok := excluded["%s"]; !ok {
+	result = append(result, fields.FieldInfo{FieldName: "%s"})
+} else if len(subFields) > 0 {
+	subIncluded, err := %s(subFields)
+	if err != nil {
+		return nil, fields.PrependParentField(err, "%s")
+	}
+	if len(subIncluded) > 0 {
+		result = append(result, fields.FieldInfo{FieldName: "%s", SubFields: subIncluded})
+	}
+}
+`,
+		field.jsonName,
+		field.jsonName,
+		getComputeIncludedFieldsFuncName(field.info),
+		field.jsonName,
+		field.jsonName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildExcludeFunc(info *objectInfo) excludeFunc {
+	return excludeFunc{
+		FuncName:         getComputeIncludedFieldsFuncName(info),
+		AllFieldsVarName: getAllFieldsVarName(info),
+		FieldCount:       len(info.subFields),
+		Cases: mapSlice(info.subFields, func(f objectField) excludeCase {
+			return excludeCase{
+				CaseNames: getCaseNames(f),
+				JSONName:  f.jsonName,
+			}
+		}),
+		FieldStmts: mapSlice(info.subFields, func(f objectField) string {
+			if f.fieldType == fieldTypeStruct {
+				return includeStmtForStructField(f)
+			}
+			if f.info == nil {
+				return includeStmtForSimpleField(f)
+			}
+			return includeStmtForObjectField(f)
+		}),
+	}
+}
@@ -3,7 +3,6 @@ package fieldmask
 import (
 	_ "embed"
 	"io"
-	"os"
 )
 
 type fieldMapGenerateParams struct {
@@ -55,34 +54,84 @@ func buildFieldMapStructs(infos []*objectInfo) []fieldMapStruct {
 //go:embed fieldmap_template
 var fieldMapTemplateString string
 
+// findRecursiveFieldPath returns the path of the first field that refers back to a message containing it
+func findRecursiveFieldPath(info *objectInfo, visiting map[objectKey]struct{}) (string, bool) {
+	visiting[info.getKey()] = struct{}{}
+	defer delete(visiting, info.getKey())
+
+	for _, f := range info.subFields {
+		if f.info == nil {
+			continue
+		}
+		if _, ok := visiting[f.info.getKey()]; ok {
+			return f.jsonName, true
+		}
+		if path, ok := findRecursiveFieldPath(f.info, visiting); ok {
+			return f.jsonName + "." + path, true
+		}
+	}
+	return "", false
+}
+
+// checkRecursiveFieldMaps returns errors for recursive message types, because field maps are nested structs
+func checkRecursiveFieldMaps(inputInfos []*objectInfo) error {
+	var errList GenerateErrors
+	for _, info := range inputInfos {
+		path, ok := findRecursiveFieldPath(info, map[objectKey]struct{}{})
+		if ok {
+			errList = append(errList, &GenerateError{
+				MessageName: info.typeName,
+				FieldPath:   path,
+				Err:         ErrRecursiveFieldMap,
+			})
+		}
+	}
+	if len(errList) > 0 {
+		return errList
+	}
+	return nil
+}
+
 func generateFieldMapCode(
 	writer io.Writer, inputInfos []*objectInfo,
 	packageName string,
-) {
+) error {
+	if err := checkRecursiveFieldMaps(inputInfos); err != nil {
+		return err
+	}
+
 	infos := traverseAllObjectInfos(inputInfos)
 
 	params := fieldMapGenerateParams{
 		PackageName: packageName,
 		Structs:     buildFieldMapStructs(infos),
 	}
-	writeToTemplate(writer, fieldMapTemplateString, params)
+	return writeToTemplate(writer, fieldMapTemplateString, params)
 }
 
-// GenerateFieldMap ...
+// GenerateFieldMap writes the generated field maps to the file, panics on errors
 func GenerateFieldMap(
 	fileName string,
 	protoMessages []ProtoMessage,
 	packageName string,
+	options ...GenerateOption,
 ) {
-	file, err := os.Create(fileName)
+	err := writeGeneratedFile(fileName, func(writer io.Writer) error {
+		return GenerateFieldMapTo(writer, protoMessages, packageName, options...)
+	})
 	if err != nil {
 		panic(err)
 	}
+}
 
-	generateFieldMapCode(file, parseMessages(protoMessages...), packageName)
-
-	err = file.Close()
+// GenerateFieldMapTo writes the generated field maps to the writer.
+// All problems of the input messages are returned together as GenerateErrors
+func GenerateFieldMapTo(
+	writer io.Writer, protoMessages []ProtoMessage, packageName string, options ...GenerateOption,
+) error {
+	infos, err := parseMessagesWithOptions(protoMessages, computeGenerateOptions(options))
 	if err != nil {
-		panic(err)
+		return err
 	}
+	return generateFieldMapCode(writer, infos, packageName)
 }
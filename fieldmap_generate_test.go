@@ -4,6 +4,7 @@ import (
 	"bytes"
 	_ "embed"
 	"github.com/QuangTung97/fieldmask/testdata/pb"
+	"github.com/QuangTung97/fieldmask/testdata/recursive"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -14,12 +15,29 @@ var fieldMapGeneratedCode string
 func TestGenerateFieldMap(t *testing.T) {
 	var buf bytes.Buffer
 
-	generateFieldMapCode(
-		&buf, parseMessages(
+	err := generateFieldMapCode(
+		&buf, parseMessagesForTest(t,
 			NewProtoMessage(&pb.ProviderInfo{}, WithFieldMapRenameType("ProviderData")),
 			NewProtoMessage(&pb.Product{}),
+			NewProtoMessage(&pb.Catalog{}),
 		), "fieldmap",
 	)
+	assert.Equal(t, nil, err)
 
 	assert.Equal(t, fieldMapGeneratedCode, buf.String())
 }
+
+func TestGenerateFieldMap_Recursive_Types(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := GenerateFieldMapTo(&buf, []ProtoMessage{
+		NewProtoMessage(&recursive.Category{}),
+		NewProtoMessage(&recursive.Thread{}),
+		NewProtoMessage(&pb.Product{}),
+	}, "fieldmap")
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "Category", FieldPath: "parent", Err: ErrRecursiveFieldMap},
+		{MessageName: "Thread", FieldPath: "comments.replies", Err: ErrRecursiveFieldMap},
+	}, err)
+	assert.Equal(t, 0, buf.Len())
+}
@@ -0,0 +1,42 @@
+package fields
+
+// ApplyOptions is the semantics of the Apply method of generated field masks
+type ApplyOptions struct {
+	// AppendRepeated appends repeated fields and merges entries of map fields, instead of replacing them
+	AppendRepeated bool
+
+	// MergeMessages applies recursively all fields of a nested message
+	// at the last position of a field path, instead of replacing the whole nested message.
+	// A replaced nested message is the one of the source message, it is not cloned
+	MergeMessages bool
+}
+
+// WithAppendRepeatedFields ...
+func WithAppendRepeatedFields() Option {
+	return func(opts *computeOptions) {
+		opts.apply.AppendRepeated = true
+	}
+}
+
+// WithMergeNestedMessages ...
+func WithMergeNestedMessages() Option {
+	return func(opts *computeOptions) {
+		opts.apply.MergeMessages = true
+	}
+}
+
+// ComputeApplyOptions returns the apply options from the list of options, used by the generated code
+func ComputeApplyOptions(options ...Option) ApplyOptions {
+	return newComputeOptions(options).apply
+}
+
+// MergeMap puts all entries of src into dst, creating dst if it is nil
+func MergeMap[K comparable, V any](dst map[K]V, src map[K]V) map[K]V {
+	if dst == nil {
+		dst = make(map[K]V, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
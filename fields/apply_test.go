@@ -0,0 +1,39 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeApplyOptions(t *testing.T) {
+	assert.Equal(t, ApplyOptions{}, ComputeApplyOptions())
+
+	assert.Equal(t, ApplyOptions{
+		AppendRepeated: true,
+	}, ComputeApplyOptions(WithMaxFields(10), WithAppendRepeatedFields()))
+
+	assert.Equal(t, ApplyOptions{
+		AppendRepeated: true,
+		MergeMessages:  true,
+	}, ComputeApplyOptions(WithAppendRepeatedFields(), WithMergeNestedMessages()))
+}
+
+func TestMergeMap(t *testing.T) {
+	t.Run("nil dst", func(t *testing.T) {
+		result := MergeMap(nil, map[string]int{"a": 1})
+		assert.Equal(t, map[string]int{"a": 1}, result)
+	})
+
+	t.Run("both nil", func(t *testing.T) {
+		result := MergeMap[string, int](nil, nil)
+		assert.Equal(t, map[string]int{}, result)
+	})
+
+	t.Run("override existing keys", func(t *testing.T) {
+		dst := map[string]int{"a": 1, "b": 2}
+		result := MergeMap(dst, map[string]int{"b": 3, "c": 4})
+		assert.Equal(t, map[string]int{"a": 1, "b": 3, "c": 4}, result)
+		assert.Equal(t, result, dst)
+	})
+}
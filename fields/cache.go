@@ -0,0 +1,156 @@
+package fields
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+)
+
+type cacheOptions struct {
+	onHit  func()
+	onMiss func()
+}
+
+// CacheOption ...
+type CacheOption func(opts *cacheOptions)
+
+// WithCacheMetrics sets the functions called on every cache hit and every cache miss, e.g. to increase counters
+func WithCacheMetrics(onHit func(), onMiss func()) CacheOption {
+	return func(opts *cacheOptions) {
+		opts.onHit = onHit
+		opts.onMiss = onMiss
+	}
+}
+
+type cacheEntry[T any] struct {
+	key   string
+	value T
+}
+
+// Cache is a bounded LRU cache of values computed from string keys, safe for concurrent use.
+// It is used by the generated XxxFieldMaskCache, keyed by both the raw input and the canonical form
+// of the masked fields, so a field mask can take up to two entries
+type Cache[T any] struct {
+	size int
+	opts cacheOptions
+
+	mut     sync.Mutex
+	order   *list.List // most recently used first
+	entries map[string]*list.Element
+}
+
+// NewCache creates a cache keeping at most size values, size must be positive
+func NewCache[T any](size int, options ...CacheOption) *Cache[T] {
+	if size <= 0 {
+		panic("fieldmask: cache size must be positive")
+	}
+
+	opts := cacheOptions{
+		onHit:  func() {},
+		onMiss: func() {},
+	}
+	for _, fn := range options {
+		fn(&opts)
+	}
+
+	return &Cache[T]{
+		size:    size,
+		opts:    opts,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// Get returns the value of key, calling compute on misses. Values are only cached when compute returns no error.
+// compute is called without holding the lock, when it is called concurrently for the same key,
+// the value stored first is returned to all callers
+func (c *Cache[T]) Get(key string, compute func() (T, error)) (T, error) {
+	if value, ok := c.lookup(key); ok {
+		c.opts.onHit()
+		return value, nil
+	}
+	c.opts.onMiss()
+
+	value, err := compute()
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return c.store(key, value), nil
+}
+
+// GetNormalized is the same as Get, but first looks up rawKey, skipping normalize on hits.
+// On misses, the value is looked up by the key returned by normalize, calling compute on misses,
+// and is also stored under rawKey. rawKey must not collide with the normalized keys, see RawCacheKey.
+// The hit and miss metrics count compute calls, a hit of either key is a single hit
+func (c *Cache[T]) GetNormalized(
+	rawKey string, normalize func() (string, error), compute func() (T, error),
+) (T, error) {
+	if value, ok := c.lookup(rawKey); ok {
+		c.opts.onHit()
+		return value, nil
+	}
+
+	key, err := normalize()
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	value, err := c.Get(key, compute)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return c.store(rawKey, value), nil
+}
+
+// RawCacheKey returns the raw key of a list of masked fields for GetNormalized.
+// It is never equal to the canonical form of any field infos, because ':' is not allowed in the field names
+func RawCacheKey(maskedFields []string) string {
+	key := []byte("raw:")
+	for _, field := range maskedFields {
+		key = strconv.AppendInt(key, int64(len(field)), 10)
+		key = append(key, ':')
+		key = append(key, field...)
+	}
+	return string(key)
+}
+
+// Len returns the number of cached values
+func (c *Cache[T]) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.order.Len()
+}
+
+func (c *Cache[T]) lookup(key string) (T, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var empty T
+		return empty, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry[T]).value, true
+}
+
+func (c *Cache[T]) store(key string, value T) T {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*cacheEntry[T]).value
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry[T]{key: key, value: value})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry[T]).key)
+	}
+	return value
+}
@@ -0,0 +1,185 @@
+package fields
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cacheTest struct {
+	cache *Cache[*int]
+
+	hits   int64
+	misses int64
+	calls  []string
+}
+
+func newCacheTest(size int) *cacheTest {
+	c := &cacheTest{}
+	c.cache = NewCache[*int](size, WithCacheMetrics(
+		func() { atomic.AddInt64(&c.hits, 1) },
+		func() { atomic.AddInt64(&c.misses, 1) },
+	))
+	return c
+}
+
+func (c *cacheTest) get(key string, value int) *int {
+	result, err := c.cache.Get(key, func() (*int, error) {
+		c.calls = append(c.calls, key)
+		return &value, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestCache(t *testing.T) {
+	t.Run("hit returns the shared value", func(t *testing.T) {
+		c := newCacheTest(2)
+
+		first := c.get("sku", 1)
+		second := c.get("sku", 2)
+
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, *second)
+		assert.Equal(t, []string{"sku"}, c.calls)
+		assert.Equal(t, int64(1), c.hits)
+		assert.Equal(t, int64(1), c.misses)
+	})
+
+	t.Run("evict least recently used", func(t *testing.T) {
+		c := newCacheTest(2)
+
+		c.get("a", 1)
+		c.get("b", 2)
+		c.get("a", 1)
+		c.get("c", 3)
+		assert.Equal(t, 2, c.cache.Len())
+
+		c.get("a", 1)
+		c.get("b", 2)
+		assert.Equal(t, []string{"a", "b", "c", "b"}, c.calls)
+		assert.Equal(t, int64(2), c.hits)
+		assert.Equal(t, int64(4), c.misses)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		c := newCacheTest(2)
+
+		computeErr := errors.New("compute error")
+		result, err := c.cache.Get("sku", func() (*int, error) {
+			return nil, computeErr
+		})
+		assert.Equal(t, computeErr, err)
+		assert.Nil(t, result)
+		assert.Equal(t, 0, c.cache.Len())
+
+		assert.Equal(t, 5, *c.get("sku", 5))
+	})
+
+	t.Run("without metrics", func(t *testing.T) {
+		c := NewCache[string](1)
+		value, err := c.Get("sku", func() (string, error) { return "value", nil })
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		assert.PanicsWithValue(t, "fieldmask: cache size must be positive", func() {
+			NewCache[string](0)
+		})
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		c := NewCache[*int](4)
+
+		results := make([]*int, 20)
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.Get("sku", func() (*int, error) {
+					value := i
+					return &value, nil
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		for _, result := range results {
+			assert.Same(t, results[0], result)
+		}
+	})
+}
+
+func (c *cacheTest) getNormalized(rawKey string, key string, value int) *int {
+	result, err := c.cache.GetNormalized(rawKey, func() (string, error) {
+		c.calls = append(c.calls, "normalize "+rawKey)
+		return key, nil
+	}, func() (*int, error) {
+		c.calls = append(c.calls, key)
+		return &value, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestCache_GetNormalized(t *testing.T) {
+	t.Run("raw and normalized keys", func(t *testing.T) {
+		c := newCacheTest(4)
+
+		first := c.getNormalized("raw:b,a", "a,b", 1)
+		second := c.getNormalized("raw:a,b", "a,b", 2)
+		third := c.getNormalized("raw:b,a", "a,b", 3)
+
+		assert.Same(t, first, second)
+		assert.Same(t, first, third)
+		assert.Equal(t, []string{"normalize raw:b,a", "a,b", "normalize raw:a,b"}, c.calls)
+		assert.Equal(t, int64(2), c.hits)
+		assert.Equal(t, int64(1), c.misses)
+		assert.Equal(t, 3, c.cache.Len())
+	})
+
+	t.Run("normalize errors are not cached", func(t *testing.T) {
+		c := newCacheTest(4)
+
+		normalizeErr := errors.New("normalize error")
+		result, err := c.cache.GetNormalized("raw:x", func() (string, error) {
+			return "", normalizeErr
+		}, func() (*int, error) {
+			panic("must not be called")
+		})
+		assert.Equal(t, normalizeErr, err)
+		assert.Nil(t, result)
+		assert.Equal(t, 0, c.cache.Len())
+		assert.Equal(t, int64(0), c.misses)
+	})
+
+	t.Run("compute errors are not cached", func(t *testing.T) {
+		c := newCacheTest(4)
+
+		computeErr := errors.New("compute error")
+		result, err := c.cache.GetNormalized("raw:x", func() (string, error) {
+			return "x", nil
+		}, func() (*int, error) {
+			return nil, computeErr
+		})
+		assert.Equal(t, computeErr, err)
+		assert.Nil(t, result)
+		assert.Equal(t, 0, c.cache.Len())
+	})
+}
+
+func TestRawCacheKey(t *testing.T) {
+	assert.Equal(t, "raw:", RawCacheKey(nil))
+	assert.Equal(t, "raw:3:sku8:provider", RawCacheKey([]string{"sku", "provider"}))
+	assert.NotEqual(t, RawCacheKey([]string{"a,b"}), RawCacheKey([]string{"a", "b"}))
+	assert.NotEqual(t, RawCacheKey([]string{"a1:b"}), RawCacheKey([]string{"a", "b"}))
+}
@@ -55,6 +55,38 @@ func ErrDuplicatedField(field string) error {
 
 var _ FieldErrorPrepend = DuplicatedFieldError{}
 
+// ===========================================
+// Subtract From Whole Field Error
+// ===========================================
+
+// SubtractFromWholeFieldError ...
+// An empty Field means the whole message
+type SubtractFromWholeFieldError struct {
+	Field string
+}
+
+func (e SubtractFromWholeFieldError) Error() string {
+	if e.Field == "" {
+		return "fieldmask: can not subtract sub fields from the whole message"
+	}
+	return fmt.Sprintf("fieldmask: can not subtract sub fields from the whole field '%s'", e.Field)
+}
+
+// PrependField ...
+func (e SubtractFromWholeFieldError) PrependField(parentField string) error {
+	if e.Field == "" {
+		return ErrSubtractFromWholeField(parentField)
+	}
+	return ErrSubtractFromWholeField(parentField + "." + e.Field)
+}
+
+// ErrSubtractFromWholeField ...
+func ErrSubtractFromWholeField(field string) error {
+	return SubtractFromWholeFieldError{Field: field}
+}
+
+var _ FieldErrorPrepend = SubtractFromWholeFieldError{}
+
 // ===========================================
 // Prepend Parent Field
 // ===========================================
@@ -81,3 +113,10 @@ var ErrExceedMaxDepth = errors.New("fieldmask: exceeded max number of field dept
 
 // ErrExceedMaxFieldComponentLength ...
 var ErrExceedMaxFieldComponentLength = errors.New("fieldmask: exceeded length of field components")
+
+// ErrInvalidJSON ...
+var ErrInvalidJSON = errors.New("fieldmask: invalid json document")
+
+// ErrExcludeAllFields is returned by the exclusion masks excluding all fields,
+// because an empty list of fields means all fields
+var ErrExcludeAllFields = errors.New("fieldmask: all fields are excluded")
@@ -0,0 +1,96 @@
+package fields
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FromFieldMask computes the field infos from the paths of a google.protobuf.FieldMask.
+// Each path is a plain list of field names separated by '.', without the brackets and the wildcard.
+// The paths are merged as in Union, e.g. 'provider' and 'provider.id' selects the whole provider field
+func FromFieldMask(fieldMask *fieldmaskpb.FieldMask, options ...Option) ([]FieldInfo, error) {
+	opts := newComputeOptions(options)
+
+	paths := fieldMask.GetPaths()
+	if len(paths) > opts.maxFields {
+		return nil, ErrExceedMaxFields
+	}
+
+	var result []FieldInfo
+	for _, path := range paths {
+		info, err := parseFieldMaskPath(path, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(result) == 0 {
+			result = []FieldInfo{info}
+			continue
+		}
+		result = mergeFieldInfos(result, []FieldInfo{info})
+	}
+
+	// the same as ComputeFieldInfos, the other name styles are validated by the generated code
+	if opts.nameStyle == NameStyleJSON {
+		if err := validateLimitedToFieldsWithOptions(result, opts); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func parseFieldMaskPath(path string, opts *computeOptions) (FieldInfo, error) {
+	names := strings.Split(path, ".")
+	if len(names) > opts.maxDepth {
+		return FieldInfo{}, ErrExceedMaxDepth
+	}
+
+	for _, name := range names {
+		if len(name) > opts.maxComponentLen {
+			return FieldInfo{}, ErrExceedMaxFieldComponentLength
+		}
+		if !isFieldMaskName(name) {
+			return FieldInfo{}, fmt.Errorf("fields: invalid field mask path '%s'", path)
+		}
+	}
+
+	info := FieldInfo{FieldName: names[len(names)-1]}
+	for i := len(names) - 2; i >= 0; i-- {
+		info = FieldInfo{FieldName: names[i], SubFields: []FieldInfo{info}}
+	}
+	return info, nil
+}
+
+func isFieldMaskName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, ch := range name {
+		if !isIdentChar(ch) {
+			return false
+		}
+	}
+	return true
+}
+
+// ToFieldMask converts the field infos to a google.protobuf.FieldMask, with one plain dot path for each leaf field.
+// The field names are kept as they are, so the paths of the field infos of the generated field masks
+// use the JSON names. Use the generated ToProto methods for the proto names required by the FieldMask spec
+func ToFieldMask(fieldInfos []FieldInfo) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{
+		Paths: appendFieldPaths(nil, "", fieldInfos),
+	}
+}
+
+func appendFieldPaths(paths []string, prefix string, fieldInfos []FieldInfo) []string {
+	for _, field := range fieldInfos {
+		path := prefix + field.FieldName
+		if len(field.SubFields) == 0 {
+			paths = append(paths, path)
+			continue
+		}
+		paths = appendFieldPaths(paths, path+".", field.SubFields)
+	}
+	return paths
+}
@@ -0,0 +1,112 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestFromFieldMask(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		infos, err := FromFieldMask(nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(infos))
+	})
+
+	t.Run("plain paths", func(t *testing.T) {
+		infos, err := FromFieldMask(&fieldmaskpb.FieldMask{
+			Paths: []string{"sku", "provider.id", "provider.image_url"},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []FieldInfo{
+					{FieldName: "id"},
+					{FieldName: "image_url"},
+				},
+			},
+		}, infos)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		infos, err := FromFieldMask(&fieldmaskpb.FieldMask{
+			Paths: []string{"sku", "provider.id"},
+		}, WithLimitedToFields([]string{"sku"}))
+		assert.Equal(t, ErrFieldNotFound("provider"), err)
+		assert.Equal(t, 0, len(infos))
+	})
+
+	t.Run("merged as union", func(t *testing.T) {
+		infos, err := FromFieldMask(&fieldmaskpb.FieldMask{
+			Paths: []string{"sku", "provider.id", "sku", "provider", "attributes.options.code", "attributes.id"},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "provider"},
+			{
+				FieldName: "attributes",
+				SubFields: []FieldInfo{
+					{
+						FieldName: "options",
+						SubFields: []FieldInfo{{FieldName: "code"}},
+					},
+					{FieldName: "id"},
+				},
+			},
+		}, infos)
+	})
+
+	t.Run("invalid paths", func(t *testing.T) {
+		for _, path := range []string{"", "provider.", ".id", "provider.{id|name}", "provider.*", "provider id"} {
+			infos, err := FromFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"sku", path}})
+			assert.Equal(t, "fields: invalid field mask path '"+path+"'", err.Error())
+			assert.Nil(t, infos)
+		}
+	})
+
+	t.Run("exceed limits", func(t *testing.T) {
+		infos, err := FromFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"a.b.c"}}, WithMaxFieldDepth(2))
+		assert.Equal(t, ErrExceedMaxDepth, err)
+		assert.Nil(t, infos)
+
+		infos, err = FromFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"a", "b"}}, WithMaxFields(1))
+		assert.Equal(t, ErrExceedMaxFields, err)
+		assert.Nil(t, infos)
+
+		infos, err = FromFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"a.abc"}}, WithMaxFieldComponentLength(2))
+		assert.Equal(t, ErrExceedMaxFieldComponentLength, err)
+		assert.Nil(t, infos)
+	})
+}
+
+func TestToFieldMask(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, &fieldmaskpb.FieldMask{}, ToFieldMask(nil))
+	})
+
+	t.Run("flatten brackets", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{"sku", "provider.{id|name}", "attributes.options.{code|name}"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []string{
+			"sku",
+			"provider.id",
+			"provider.name",
+			"attributes.options.code",
+			"attributes.options.name",
+		}, ToFieldMask(infos).GetPaths())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{"sku", "seller.{id|code}", "attr"})
+		assert.Equal(t, nil, err)
+
+		newInfos, err := FromFieldMask(ToFieldMask(infos))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, infos, newInfos)
+	})
+}
@@ -6,6 +6,32 @@ type FieldInfo struct {
 	SubFields []FieldInfo
 }
 
+// Wildcard is the field name of '*', meaning all direct fields of a message.
+// At the top level, it means the full message explicitly, different from an empty list of fields
+const Wildcard = "*"
+
+// HasWildcard checks whether the list of fields contains the wildcard field
+func HasWildcard(fieldInfos []FieldInfo) bool {
+	_, ok := findField(fieldInfos, Wildcard)
+	return ok
+}
+
+// ExpandWildcard replaces the wildcard field by allFields (the list of all direct fields of a message).
+// Returns the input unchanged if it does not contain the wildcard field
+func ExpandWildcard(fieldInfos []FieldInfo, allFields []FieldInfo) []FieldInfo {
+	if !HasWildcard(fieldInfos) {
+		return fieldInfos
+	}
+
+	others := make([]FieldInfo, 0, len(fieldInfos)-1)
+	for _, f := range fieldInfos {
+		if f.FieldName != Wildcard {
+			others = append(others, f)
+		}
+	}
+	return mergeFieldInfos(allFields, others)
+}
+
 func getFieldCollector(fields []string, opts *computeOptions) (*fieldInfoCollector, error) {
 	coll := newCollector(opts)
 
@@ -19,7 +45,7 @@ func getFieldCollector(fields []string, opts *computeOptions) (*fieldInfoCollect
 	return coll, nil
 }
 
-func validateLimitedToFields(fields []FieldInfo, coll *fieldInfoCollector) error {
+func checkFieldsInCollector(fields []FieldInfo, coll *fieldInfoCollector) error {
 	for _, f := range fields {
 		if coll == nil {
 			return ErrFieldNotFound(f.FieldName)
@@ -30,7 +56,7 @@ func validateLimitedToFields(fields []FieldInfo, coll *fieldInfoCollector) error
 			return ErrFieldNotFound(f.FieldName)
 		}
 		if len(f.SubFields) > 0 {
-			err := validateLimitedToFields(f.SubFields, subColl)
+			err := checkFieldsInCollector(f.SubFields, subColl)
 			if err != nil {
 				return PrependParentField(err, f.FieldName)
 			}
@@ -50,15 +76,30 @@ func ComputeFieldInfos(fields []string, options ...Option) ([]FieldInfo, error)
 
 	resultFields := resultCollector.toFieldInfos()
 
-	if len(opts.limitedToFields) > 0 {
-		allowedFieldsCollector, err := getFieldCollector(opts.limitedToFields, opts)
-		if err != nil {
-			return nil, err
-		}
-		if err := validateLimitedToFields(resultFields, allowedFieldsCollector); err != nil {
+	// with other name styles, the proto names can not be validated without the message types,
+	// the generated code calls ValidateLimitedToFields after normalizing to the JSON names
+	if opts.nameStyle == NameStyleJSON {
+		if err := validateLimitedToFieldsWithOptions(resultFields, opts); err != nil {
 			return nil, err
 		}
 	}
 
 	return resultFields, nil
 }
+
+// ValidateLimitedToFields checks the field infos (with JSON names) are in the list of WithLimitedToFields
+func ValidateLimitedToFields(fieldInfos []FieldInfo, options ...Option) error {
+	return validateLimitedToFieldsWithOptions(fieldInfos, newComputeOptions(options))
+}
+
+func validateLimitedToFieldsWithOptions(fieldInfos []FieldInfo, opts *computeOptions) error {
+	if len(opts.limitedToFields) == 0 {
+		return nil
+	}
+
+	allowedFieldsCollector, err := getFieldCollector(opts.limitedToFields, opts)
+	if err != nil {
+		return err
+	}
+	return checkFieldsInCollector(fieldInfos, allowedFieldsCollector)
+}
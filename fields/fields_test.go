@@ -319,3 +319,88 @@ func TestComputeFieldInfos_WithLimitedToFields(t *testing.T) {
 		assert.Equal(t, []FieldInfo(nil), infos)
 	})
 }
+
+func TestComputeFieldInfos_WithNameStyle(t *testing.T) {
+	t.Run("not validate limited fields when not json style", func(t *testing.T) {
+		infos, err := ComputeFieldInfos(
+			[]string{"sku", "seller.image_url"},
+			WithLimitedToFields([]string{"sku", "seller.imageUrl"}),
+			WithNameStyle(NameStyleProto),
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "seller",
+				SubFields: []FieldInfo{
+					{FieldName: "image_url"},
+				},
+			},
+		}, infos)
+	})
+
+	t.Run("validate limited fields after normalized", func(t *testing.T) {
+		options := []Option{
+			WithLimitedToFields([]string{"sku", "seller.imageUrl"}),
+			WithNameStyle(NameStyleProto),
+		}
+
+		err := ValidateLimitedToFields([]FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "seller", SubFields: []FieldInfo{{FieldName: "imageUrl"}}},
+		}, options...)
+		assert.Equal(t, nil, err)
+
+		err = ValidateLimitedToFields([]FieldInfo{
+			{FieldName: "seller", SubFields: []FieldInfo{{FieldName: "name"}}},
+		}, options...)
+		assert.Equal(t, ErrFieldNotFound("seller.name"), err)
+	})
+}
+
+func TestNameStyle_Match(t *testing.T) {
+	assert.Equal(t, true, NameStyleJSON.Match("imageUrl", "imageUrl", "image_url"))
+	assert.Equal(t, false, NameStyleJSON.Match("image_url", "imageUrl", "image_url"))
+
+	assert.Equal(t, false, NameStyleProto.Match("imageUrl", "imageUrl", "image_url"))
+	assert.Equal(t, true, NameStyleProto.Match("image_url", "imageUrl", "image_url"))
+
+	assert.Equal(t, true, NameStyleBoth.Match("imageUrl", "imageUrl", "image_url"))
+	assert.Equal(t, true, NameStyleBoth.Match("image_url", "imageUrl", "image_url"))
+	assert.Equal(t, false, NameStyleBoth.Match("imageURL", "imageUrl", "image_url"))
+
+	assert.Equal(t, NameStyleJSON, ComputeNameStyle())
+	assert.Equal(t, NameStyleBoth, ComputeNameStyle(WithNameStyle(NameStyleBoth)))
+}
+
+func TestExpandWildcard(t *testing.T) {
+	allFields := []FieldInfo{
+		{FieldName: "sku"},
+		{FieldName: "name"},
+		{FieldName: "seller"},
+	}
+
+	t.Run("without wildcard", func(t *testing.T) {
+		infos := []FieldInfo{{FieldName: "sku"}}
+		assert.Equal(t, false, HasWildcard(infos))
+		assert.Equal(t, infos, ExpandWildcard(infos, allFields))
+	})
+
+	t.Run("with wildcard", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{"seller.id", "*"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, HasWildcard(infos))
+		assert.Equal(t, allFields, ExpandWildcard(infos, allFields))
+	})
+
+	t.Run("with wildcard and unknown field", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{"*", "unknown"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "name"},
+			{FieldName: "seller"},
+			{FieldName: "unknown"},
+		}, ExpandWildcard(infos, allFields))
+	})
+}
@@ -0,0 +1,65 @@
+package fields
+
+import (
+	"sort"
+	"strings"
+)
+
+// Format returns one dot path for each leaf field, sorted by field names at every level.
+// An empty list of fields is formatted to nil, the same as FormatCompact
+func Format(fieldInfos []FieldInfo) []string {
+	return appendFieldPaths(nil, "", sortFieldInfos(fieldInfos))
+}
+
+// FormatCompact returns one path for each top level field, sorted by field names at every level.
+// Sibling sub fields are grouped using the bracket syntax, e.g. 'provider.{id|name}'.
+// An empty list of fields is formatted to nil, the same as Format
+func FormatCompact(fieldInfos []FieldInfo) []string {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+
+	sortedInfos := sortFieldInfos(fieldInfos)
+
+	result := make([]string, 0, len(sortedInfos))
+	for _, field := range sortedInfos {
+		result = append(result, formatCompactField(field))
+	}
+	return result
+}
+
+func formatCompactField(field FieldInfo) string {
+	switch len(field.SubFields) {
+	case 0:
+		return field.FieldName
+
+	case 1:
+		return field.FieldName + "." + formatCompactField(field.SubFields[0])
+
+	default:
+		subFields := make([]string, 0, len(field.SubFields))
+		for _, subField := range field.SubFields {
+			subFields = append(subFields, formatCompactField(subField))
+		}
+		return field.FieldName + ".{" + strings.Join(subFields, "|") + "}"
+	}
+}
+
+// sortFieldInfos returns a sorted copy of the field infos, without modifying the input
+func sortFieldInfos(fieldInfos []FieldInfo) []FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+
+	result := make([]FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		result = append(result, FieldInfo{
+			FieldName: field.FieldName,
+			SubFields: sortFieldInfos(field.SubFields),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].FieldName < result[j].FieldName
+	})
+	return result
+}
@@ -0,0 +1,91 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, []string(nil), Format(nil))
+		assert.Equal(t, []string(nil), Format([]FieldInfo{}))
+	})
+
+	t.Run("sorted leaf paths", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{
+			"sku", "provider.{name|id}", "attributes.options.{name|code}", "brandCodes",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []string{
+			"attributes.options.code",
+			"attributes.options.name",
+			"brandCodes",
+			"provider.id",
+			"provider.name",
+			"sku",
+		}, Format(infos))
+	})
+
+	t.Run("not modify input", func(t *testing.T) {
+		infos := []FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "name"},
+		}
+		assert.Equal(t, []string{"name", "sku"}, Format(infos))
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "name"},
+		}, infos)
+	})
+}
+
+func TestFormatCompact(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, []string(nil), FormatCompact(nil))
+		assert.Equal(t, []string(nil), FormatCompact([]FieldInfo{}))
+	})
+
+	t.Run("empty is the same as format", func(t *testing.T) {
+		assert.Equal(t, Format(nil), FormatCompact(nil))
+		assert.Equal(t, Format([]FieldInfo{}), FormatCompact([]FieldInfo{}))
+	})
+
+	t.Run("brackets", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{
+			"sku", "provider.{name|id}", "attributes.options.{name|code}",
+			"seller.{info.{name|code}|id}",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []string{
+			"attributes.options.{code|name}",
+			"provider.{id|name}",
+			"seller.{id|info.{code|name}}",
+			"sku",
+		}, FormatCompact(infos))
+	})
+
+	t.Run("same output for different orders", func(t *testing.T) {
+		infos1, err := ComputeFieldInfos([]string{"sku", "provider.id", "provider.name"})
+		assert.Equal(t, nil, err)
+
+		infos2, err := ComputeFieldInfos([]string{"provider.{name|id}", "sku"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, FormatCompact(infos1), FormatCompact(infos2))
+		assert.Equal(t, Format(infos1), Format(infos2))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		infos, err := ComputeFieldInfos([]string{
+			"attributes.options.{code|name}", "provider.{id|name}", "seller.{id|info.{code|name}}", "sku",
+		})
+		assert.Equal(t, nil, err)
+
+		newInfos, err := ComputeFieldInfos(FormatCompact(infos))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, infos, newInfos)
+	})
+}
@@ -0,0 +1,300 @@
+package fields
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type jsonOptions struct {
+	mapFields map[string]struct{}
+}
+
+// JSONOption ...
+type JSONOption func(opts *jsonOptions)
+
+// WithJSONMapFields marks the fields at paths (field names separated by '.', without the keys of the maps)
+// as map fields, the sub fields of a map field are applied to every value of the map, instead of its keys.
+// E.g. with the map field 'providers', 'providers.name' keeps the names of all values of providers
+func WithJSONMapFields(paths ...string) JSONOption {
+	return func(opts *jsonOptions) {
+		for _, path := range paths {
+			opts.mapFields[path] = struct{}{}
+		}
+	}
+}
+
+// MaskJSON keeps only the keys of the fields in a JSON document, in the order of the document,
+// without unmarshalling it. The fields of an array are applied to every element of the array,
+// values that are not objects or arrays are kept. The same as the generated Mask,
+// a field with empty SubFields means the whole subtree and an empty list of fields means the whole document.
+// The result is compacted.
+//
+// The JSON objects of map fields can not be distinguished from messages, the sub fields are matched with the keys
+// of the maps unless the map fields are marked by WithJSONMapFields
+func MaskJSON(data []byte, fieldInfos []FieldInfo, options ...JSONOption) ([]byte, error) {
+	if !json.Valid(data) {
+		return nil, ErrInvalidJSON
+	}
+
+	opts := jsonOptions{mapFields: map[string]struct{}{}}
+	for _, fn := range options {
+		fn(&opts)
+	}
+
+	m := &jsonMasker{
+		data:      data,
+		result:    make([]byte, 0, len(data)),
+		mapFields: opts.mapFields,
+	}
+	if len(fieldInfos) == 0 {
+		m.copyValue()
+	} else {
+		m.maskValue(fieldInfos, "")
+	}
+	return m.result, nil
+}
+
+// jsonMasker scans a valid JSON document, writing the kept values into result
+type jsonMasker struct {
+	data   []byte
+	pos    int
+	result []byte
+
+	mapFields map[string]struct{}
+}
+
+func (m *jsonMasker) skipSpaces() {
+	for m.pos < len(m.data) {
+		switch m.data[m.pos] {
+		case ' ', '\t', '\n', '\r':
+			m.pos++
+		default:
+			return
+		}
+	}
+}
+
+// next skips spaces and returns the next byte
+func (m *jsonMasker) next() byte {
+	m.skipSpaces()
+	ch := m.data[m.pos]
+	m.pos++
+	return ch
+}
+
+func (m *jsonMasker) peek() byte {
+	m.skipSpaces()
+	return m.data[m.pos]
+}
+
+// scanString returns the raw string at the current position, including the quotes
+func (m *jsonMasker) scanString() []byte {
+	m.skipSpaces()
+	begin := m.pos
+	m.pos++
+	for m.data[m.pos] != '"' {
+		if m.data[m.pos] == '\\' {
+			m.pos++
+		}
+		m.pos++
+	}
+	m.pos++
+	return m.data[begin:m.pos]
+}
+
+func (m *jsonMasker) skipLiteral() {
+	for m.pos < len(m.data) {
+		switch m.data[m.pos] {
+		case ',', '}', ']', ' ', '\t', '\n', '\r':
+			return
+		default:
+			m.pos++
+		}
+	}
+}
+
+// skipValue skips the whole value at the current position
+func (m *jsonMasker) skipValue() {
+	switch m.peek() {
+	case '{':
+		m.next()
+		if m.peek() == '}' {
+			m.next()
+			return
+		}
+		for {
+			m.scanString()
+			m.next() // colon
+			m.skipValue()
+			if m.next() == '}' {
+				return
+			}
+		}
+
+	case '[':
+		m.next()
+		if m.peek() == ']' {
+			m.next()
+			return
+		}
+		for {
+			m.skipValue()
+			if m.next() == ']' {
+				return
+			}
+		}
+
+	case '"':
+		m.scanString()
+
+	default:
+		m.skipLiteral()
+	}
+}
+
+// copyValue writes the compacted whole value at the current position
+func (m *jsonMasker) copyValue() {
+	m.skipSpaces()
+	begin := m.pos
+	m.skipValue()
+	m.result = appendCompact(m.result, m.data[begin:m.pos])
+}
+
+// appendCompact appends the value without the spaces outside of strings
+func appendCompact(result []byte, value []byte) []byte {
+	inString := false
+	for index := 0; index < len(value); index++ {
+		ch := value[index]
+		switch {
+		case inString && ch == '\\':
+			result = append(result, ch, value[index+1])
+			index++
+			continue
+		case ch == '"':
+			inString = !inString
+		case !inString && (ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'):
+			continue
+		}
+		result = append(result, ch)
+	}
+	return result
+}
+
+// maskValue masks the value of the field at path, the path is empty for the whole document
+func (m *jsonMasker) maskValue(fieldInfos []FieldInfo, path string) {
+	if HasWildcard(fieldInfos) {
+		m.copyValue()
+		return
+	}
+
+	if _, ok := m.mapFields[path]; ok && m.peek() == '{' {
+		m.maskMap(fieldInfos, path)
+		return
+	}
+	m.maskMessage(fieldInfos, path)
+}
+
+// maskMessage masks the value of a message, or the value in a map field
+func (m *jsonMasker) maskMessage(fieldInfos []FieldInfo, path string) {
+	switch m.peek() {
+	case '{':
+		m.maskObject(fieldInfos, path)
+	case '[':
+		m.maskArray(fieldInfos, path)
+	default:
+		m.copyValue()
+	}
+}
+
+func (m *jsonMasker) maskObject(fieldInfos []FieldInfo, path string) {
+	m.next()
+	m.result = append(m.result, '{')
+	if m.peek() == '}' {
+		m.next()
+		m.result = append(m.result, '}')
+		return
+	}
+
+	first := true
+	for {
+		rawKey := m.scanString()
+		m.next() // colon
+
+		field, ok := findField(fieldInfos, decodeJSONKey(rawKey))
+		if ok {
+			if !first {
+				m.result = append(m.result, ',')
+			}
+			first = false
+
+			m.result = append(m.result, rawKey...)
+			m.result = append(m.result, ':')
+			if len(field.SubFields) == 0 {
+				m.copyValue()
+			} else {
+				m.maskValue(field.SubFields, joinJSONPath(path, field.FieldName))
+			}
+		} else {
+			m.skipValue()
+		}
+
+		if m.next() == '}' {
+			m.result = append(m.result, '}')
+			return
+		}
+	}
+}
+
+// maskMap keeps all keys of the object of a map field, masking each value with the fields
+func (m *jsonMasker) maskMap(fieldInfos []FieldInfo, path string) {
+	m.result = append(m.result, m.next())
+	if m.peek() == '}' {
+		m.result = append(m.result, m.next())
+		return
+	}
+
+	for {
+		m.result = append(m.result, m.scanString()...)
+		m.result = append(m.result, m.next()) // colon
+		m.maskMessage(fieldInfos, path)
+
+		ch := m.next()
+		m.result = append(m.result, ch)
+		if ch == '}' {
+			return
+		}
+	}
+}
+
+func (m *jsonMasker) maskArray(fieldInfos []FieldInfo, path string) {
+	m.result = append(m.result, m.next())
+	if m.peek() == ']' {
+		m.result = append(m.result, m.next())
+		return
+	}
+
+	for {
+		m.maskValue(fieldInfos, path)
+		ch := m.next()
+		m.result = append(m.result, ch)
+		if ch == ']' {
+			return
+		}
+	}
+}
+
+func joinJSONPath(path string, fieldName string) string {
+	if path == "" {
+		return fieldName
+	}
+	return path + "." + fieldName
+}
+
+func decodeJSONKey(rawKey []byte) string {
+	if bytes.IndexByte(rawKey, '\\') < 0 {
+		return string(rawKey[1 : len(rawKey)-1])
+	}
+	var key string
+	_ = json.Unmarshal(rawKey, &key)
+	return key
+}
@@ -0,0 +1,155 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func maskJSONString(data string, fields ...string) string {
+	result, err := MaskJSON([]byte(data), mustComputeFieldInfos(fields...))
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+func TestMaskJSON(t *testing.T) {
+	const product = `{
+		"sku": "SKU01",
+		"provider": {"id": 21, "name": "Provider Name", "logo": null},
+		"attributes": [
+			{"id": 1, "code": "COLOR", "options": [{"code": "RED", "name": "Red"}]},
+			{"id": 2, "code": "SIZE", "options": []}
+		],
+		"sellerIds": [1, 2, 3],
+		"price": -1.5e+3
+	}`
+
+	t.Run("simple fields keep the order of the document", func(t *testing.T) {
+		assert.Equal(t,
+			`{"sku":"SKU01","sellerIds":[1,2,3],"price":-1.5e+3}`,
+			maskJSONString(product, "price", "sellerIds", "sku"),
+		)
+	})
+
+	t.Run("sub fields", func(t *testing.T) {
+		assert.Equal(t,
+			`{"provider":{"id":21,"logo":null}}`,
+			maskJSONString(product, "provider.{logo|id}"),
+		)
+	})
+
+	t.Run("arrays", func(t *testing.T) {
+		assert.Equal(t,
+			`{"attributes":[{"code":"COLOR","options":[{"name":"Red"}]},{"code":"SIZE","options":[]}]}`,
+			maskJSONString(product, "attributes.{code|options.name}"),
+		)
+	})
+
+	t.Run("whole subtree", func(t *testing.T) {
+		assert.Equal(t,
+			`{"provider":{"id":21,"name":"Provider Name","logo":null}}`,
+			maskJSONString(product, "provider"),
+		)
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		assert.Equal(t,
+			`{"provider":{"id":21,"name":"Provider Name","logo":null}}`,
+			maskJSONString(product, "provider.*"),
+		)
+		assert.Equal(t,
+			`{"a":{"b":1},"c":[]}`,
+			maskJSONString(`{"a": {"b": 1}, "c": []}`, "*", "a.b"),
+		)
+	})
+
+	t.Run("empty fields means the whole document", func(t *testing.T) {
+		assert.Equal(t, `{"a":[1,{"b":" x "}],"c":true}`, maskJSONString(` {"a": [1, {"b": " x "}], "c": true} `))
+	})
+
+	t.Run("top level array", func(t *testing.T) {
+		assert.Equal(t,
+			`[{"sku":"A"},{"sku":"B"},null]`,
+			maskJSONString(`[{"sku": "A", "name": "a"}, {"name": "b", "sku": "B"}, null]`, "sku"),
+		)
+	})
+
+	t.Run("non object values with sub fields are kept", func(t *testing.T) {
+		assert.Equal(t,
+			`{"provider":null,"sku":"A"}`,
+			maskJSONString(`{"provider": null, "sku": "A"}`, "provider.id", "sku.code"),
+		)
+	})
+
+	t.Run("not found fields", func(t *testing.T) {
+		assert.Equal(t, `{}`, maskJSONString(product, "unknown"))
+		assert.Equal(t, `{"provider":{}}`, maskJSONString(product, "provider.unknown"))
+	})
+
+	t.Run("escaped keys and strings", func(t *testing.T) {
+		assert.Equal(t,
+			`{"sku":"a\"b}","\u0069d":1}`,
+			maskJSONString(`{"sku": "a\"b}", "name": "{", "\u0069d": 1}`, "sku", "id"),
+		)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		result, err := MaskJSON([]byte(`{"sku": `), mustComputeFieldInfos("sku"))
+		assert.Equal(t, ErrInvalidJSON, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestMaskJSON_Map_Fields(t *testing.T) {
+	const catalog = `{
+		"m": {"k1": {"a": 1, "b": 2}, "k2": {"b": 3}, "k3": null},
+		"providers": {"21": {"id": 21, "name": "Provider 21", "info": {"a": 1, "m": {"x": {"a": 4, "b": 5}}}}},
+		"items": [{"m": {"k4": {"a": 6, "b": 7}}}]
+	}`
+
+	t.Run("map objects are masked as messages by default", func(t *testing.T) {
+		assert.Equal(t, `{"m":{}}`, maskJSONString(`{"m":{"k1":{"a":1,"b":2}}}`, "m.a"))
+		assert.Equal(t, `{"m":{"k1":{"a":1,"b":2}}}`, maskJSONString(`{"m":{"k1":{"a":1,"b":2}}}`, "m.k1"))
+	})
+
+	maskWithMaps := func(data string, fields ...string) string {
+		result, err := MaskJSON([]byte(data), mustComputeFieldInfos(fields...),
+			WithJSONMapFields("m", "providers", "providers.info.m", "items.m"),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return string(result)
+	}
+
+	t.Run("sub fields applied to every value", func(t *testing.T) {
+		assert.Equal(t,
+			`{"m":{"k1":{"a":1},"k2":{},"k3":null}}`,
+			maskWithMaps(catalog, "m.a"),
+		)
+	})
+
+	t.Run("nested map fields", func(t *testing.T) {
+		assert.Equal(t,
+			`{"providers":{"21":{"name":"Provider 21","info":{"m":{"x":{"b":5}}}}},"items":[{"m":{"k4":{"a":6}}}]}`,
+			maskWithMaps(catalog, "providers.{name|info.m.b}", "items.m.a"),
+		)
+	})
+
+	t.Run("whole map and wildcard", func(t *testing.T) {
+		assert.Equal(t,
+			`{"m":{"k1":{"a":1,"b":2},"k2":{"b":3},"k3":null}}`,
+			maskWithMaps(catalog, "m"),
+		)
+		assert.Equal(t,
+			`{"m":{"k1":{"a":1,"b":2},"k2":{"b":3},"k3":null}}`,
+			maskWithMaps(catalog, "m.*"),
+		)
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.Equal(t, `{"m":{}}`, maskWithMaps(`{"m": { }}`, "m.a"))
+	})
+}
@@ -0,0 +1,39 @@
+package fields
+
+// NameStyle specifies which names of proto fields are accepted in field paths
+type NameStyle int
+
+const (
+	// NameStyleJSON only accepts the JSON names (lowerCamelCase), e.g. 'imageUrl'. This is the default
+	NameStyleJSON NameStyle = iota
+
+	// NameStyleProto only accepts the names in the .proto files (snake_case), e.g. 'image_url'
+	NameStyleProto
+
+	// NameStyleBoth accepts both the JSON names and the proto names
+	NameStyleBoth
+)
+
+// Match checks whether the name is accepted as the field with jsonName and protoName, used by the generated code
+func (s NameStyle) Match(name string, jsonName string, protoName string) bool {
+	switch s {
+	case NameStyleProto:
+		return name == protoName
+	case NameStyleBoth:
+		return name == jsonName || name == protoName
+	default:
+		return name == jsonName
+	}
+}
+
+// WithNameStyle ...
+func WithNameStyle(style NameStyle) Option {
+	return func(opts *computeOptions) {
+		opts.nameStyle = style
+	}
+}
+
+// ComputeNameStyle returns the name style from the list of options, used by the generated code
+func ComputeNameStyle(options ...Option) NameStyle {
+	return newComputeOptions(options).nameStyle
+}
@@ -5,6 +5,9 @@ type computeOptions struct {
 	maxFields       int
 	maxDepth        int
 	limitedToFields []string
+	nameStyle       NameStyle
+
+	apply ApplyOptions
 }
 
 func newComputeOptions(options []Option) *computeOptions {
@@ -16,8 +16,10 @@ func newParser(input string, collector *fieldInfoCollector) *parser {
 // Full Grammar
 // =============================================
 // FieldExpr => <Ident> FieldLevelList
+//			   | <Wildcard>
 // FieldLevelList => <Dot> <Ident> FieldLevelList
 //				  | <Dot> FieldExprBracket
+//				  | <Dot> <Wildcard>
 // 			      | <empty>
 // FieldExprBracket => <Open Bracket> <FieldExpr> FieldSiblingList <Close Bracket>
 // FieldSiblingList => <Vertical Line> <FieldExpr> FieldSiblingList
@@ -85,6 +87,10 @@ func (p *parser) parseFieldExprGetErrorForTokenIsNotDot(fieldElem string, state
 
 //revive:disable-next-line:cognitive-complexity
 func (p *parser) parseFieldExpr(coll *fieldInfoCollector, state parseFieldExprState) error {
+	if p.sc.getTokenType() == tokenTypeWildcard {
+		return p.parseWildcard(coll, state)
+	}
+
 	if p.sc.getTokenType() != tokenTypeIdent {
 		return p.parseFieldExprGetErrorForFirstToken(state)
 	}
@@ -130,6 +136,10 @@ func (p *parser) parseFieldExpr(coll *fieldInfoCollector, state parseFieldExprSt
 			return p.addParentPrefix(p.parseFieldExprBracket(coll), parentPrefix)
 		}
 
+		if p.sc.getTokenType() == tokenTypeWildcard {
+			return p.addParentPrefix(p.parseWildcard(coll, state), parentPrefix)
+		}
+
 		return p.sc.withErrorf(
 			"expecting an identifier or a '{' after '.', instead found '%s'",
 			p.sc.getTokenString(),
@@ -137,6 +147,16 @@ func (p *parser) parseFieldExpr(coll *fieldInfoCollector, state parseFieldExprSt
 	}
 }
 
+func (p *parser) parseWildcard(coll *fieldInfoCollector, state parseFieldExprState) error {
+	if p.sc.next() && p.sc.getTokenType() == tokenTypeDot {
+		return p.sc.withErrorf("not allow '.' after '*'")
+	}
+	if err := p.parseFieldExprGetErrorForTokenIsNotDot(Wildcard, state); err != nil {
+		return err
+	}
+	return coll.addIfNotExisted(Wildcard, false)
+}
+
 func (p *parser) parseFieldExprBracket(coll *fieldInfoCollector) error {
 	if !p.sc.next() {
 		return p.sc.withErrorf("expecting an identifier after '{'")
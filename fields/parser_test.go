@@ -269,3 +269,75 @@ func TestParser_Error_From_Scanner(t *testing.T) {
 		assert.Equal(t, errors.New("fields: character '?' is not allowed"), err)
 	})
 }
+
+func TestParser_Wildcard(t *testing.T) {
+	t.Run("top level", func(t *testing.T) {
+		p := newParserTest("*")
+		err := p.parse()
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []FieldInfo{
+			{FieldName: Wildcard},
+		}, p.collector.toFieldInfos())
+	})
+
+	t.Run("after dot", func(t *testing.T) {
+		p := newParserTest("attributes.options.*")
+		err := p.parse()
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []FieldInfo{
+			{
+				FieldName: "attributes",
+				SubFields: []FieldInfo{
+					{
+						FieldName: "options",
+						SubFields: []FieldInfo{
+							{FieldName: Wildcard},
+						},
+					},
+				},
+			},
+		}, p.collector.toFieldInfos())
+	})
+
+	t.Run("inside brackets", func(t *testing.T) {
+		p := newParserTest("info.{sku|seller.*|*}")
+		err := p.parse()
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, []FieldInfo{
+			{
+				FieldName: "info",
+				SubFields: []FieldInfo{
+					{FieldName: "sku"},
+					{
+						FieldName: "seller",
+						SubFields: []FieldInfo{
+							{FieldName: Wildcard},
+						},
+					},
+					{FieldName: Wildcard},
+				},
+			},
+		}, p.collector.toFieldInfos())
+	})
+
+	t.Run("not allow dot after wildcard", func(t *testing.T) {
+		p := newParserTest("attributes.*.code")
+		err := p.parse()
+		assert.Equal(t, errors.New("fields: not allow '.' after '*'"), err)
+	})
+
+	t.Run("not allow extra token after wildcard", func(t *testing.T) {
+		p := newParserTest("attributes.*}")
+		err := p.parse()
+		assert.Equal(t, errors.New("fields: expected '.' after identifier '*', instead found '}'"), err)
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		p := newParserTest("provider.{*|*}")
+		err := p.parse()
+		assert.Equal(t, ErrDuplicatedField("provider.*"), err)
+	})
+}
@@ -0,0 +1,27 @@
+package fields
+
+// Path is a field path in the syntax of ComputeFieldInfos, usually built by the generated path builders,
+// e.g. ProductPaths.Attributes().Options().Code() is the path "attributes.options.code"
+type Path string
+
+// String ...
+func (p Path) String() string {
+	return string(p)
+}
+
+// Append returns the path of the sub field fieldName
+func (p Path) Append(fieldName string) Path {
+	if p == "" {
+		return Path(fieldName)
+	}
+	return p + "." + Path(fieldName)
+}
+
+// PathStrings converts the list of paths to the list of strings accepted by ComputeFieldInfos
+func PathStrings(paths ...Path) []string {
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		result = append(result, string(p))
+	}
+	return result
+}
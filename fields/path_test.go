@@ -0,0 +1,29 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPath(t *testing.T) {
+	t.Run("append", func(t *testing.T) {
+		p := Path("").Append("attributes").Append("options").Append("code")
+		assert.Equal(t, Path("attributes.options.code"), p)
+		assert.Equal(t, "attributes.options.code", p.String())
+	})
+
+	t.Run("path strings", func(t *testing.T) {
+		assert.Equal(t, []string{"sku", "provider.name"}, PathStrings("sku", "provider.name"))
+		assert.Equal(t, []string{}, PathStrings())
+	})
+
+	t.Run("compute field infos", func(t *testing.T) {
+		infos, err := ComputeFieldInfos(PathStrings(Path("provider").Append("name"), "sku"))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []FieldInfo{
+			{FieldName: "provider", SubFields: []FieldInfo{{FieldName: "name"}}},
+			{FieldName: "sku"},
+		}, infos)
+	})
+}
@@ -27,6 +27,7 @@ const (
 	tokenTypeOpeningBracket
 	tokenTypeClosingBracket
 	tokenTypeVerticalLine
+	tokenTypeWildcard
 )
 
 func newScanner(s string) *scanner {
@@ -43,7 +44,23 @@ func newScanner(s string) *scanner {
 }
 
 func isIdentChar(ch rune) bool {
-	return unicode.IsDigit(ch) || unicode.IsLetter(ch)
+	return unicode.IsDigit(ch) || unicode.IsLetter(ch) || ch == '_'
+}
+
+func (s *scanner) handleStartOfIdent(ch rune) error {
+	if isIdentChar(ch) {
+		s.state = tokenTypeIdent
+		s.ident = s.ident[:0]
+		s.ident = append(s.ident, ch)
+		return nil
+	}
+	if ch == 0 {
+		return nil
+	}
+	if ch == ' ' {
+		return fmt.Errorf("fields: not allow spaces")
+	}
+	return fmt.Errorf("fields: character '%c' is not allowed", ch)
 }
 
 func (s *scanner) handleNextChar(ch rune) (endOfToken bool, err error) {
@@ -58,21 +75,11 @@ func (s *scanner) handleNextChar(ch rune) (endOfToken bool, err error) {
 			s.state = tokenTypeClosingBracket
 		case '|':
 			s.state = tokenTypeVerticalLine
+		case '*':
+			s.state = tokenTypeWildcard
 
 		default:
-			if isIdentChar(ch) {
-				s.state = tokenTypeIdent
-				s.ident = s.ident[:0]
-				s.ident = append(s.ident, ch)
-				return false, nil
-			}
-			if ch == 0 {
-				return false, nil
-			}
-			if ch == ' ' {
-				return false, fmt.Errorf("fields: not allow spaces")
-			}
-			return false, fmt.Errorf("fields: character '%c' is not allowed", ch)
+			return false, s.handleStartOfIdent(ch)
 		}
 		return false, nil
 
@@ -83,7 +90,7 @@ func (s *scanner) handleNextChar(ch rune) (endOfToken bool, err error) {
 		}
 		return true, nil
 
-	case tokenTypeDot, tokenTypeOpeningBracket, tokenTypeClosingBracket, tokenTypeVerticalLine:
+	case tokenTypeDot, tokenTypeOpeningBracket, tokenTypeClosingBracket, tokenTypeVerticalLine, tokenTypeWildcard:
 		return true, nil
 
 	default:
@@ -126,6 +133,8 @@ func (s *scanner) getTokenString() string {
 		return "{"
 	case tokenTypeClosingBracket:
 		return "}"
+	case tokenTypeWildcard:
+		return Wildcard
 	default:
 		return ""
 	}
@@ -32,6 +32,33 @@ func TestScanner(t *testing.T) {
 		assert.Equal(t, nil, s.getErr())
 	})
 
+	t.Run("ident with underscore", func(t *testing.T) {
+		s := newScanner("provider.image_url")
+
+		assert.Equal(t, true, s.next())
+		assert.Equal(t, tokenTypeIdent, s.getTokenType())
+		assert.Equal(t, "provider", s.getIdentString())
+
+		assert.Equal(t, true, s.next())
+		assert.Equal(t, tokenTypeDot, s.getTokenType())
+
+		assert.Equal(t, true, s.next())
+		assert.Equal(t, tokenTypeIdent, s.getTokenType())
+		assert.Equal(t, "image_url", s.getIdentString())
+
+		assert.Equal(t, false, s.next())
+		assert.Equal(t, tokenTypeUnspecified, s.getTokenType())
+		assert.Equal(t, nil, s.getErr())
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		tokens, idents := scanStringTest("provider.*")
+		assert.Equal(t, []tokenType{
+			tokenTypeIdent, tokenTypeDot, tokenTypeWildcard,
+		}, tokens)
+		assert.Equal(t, []string{"provider"}, idents)
+	})
+
 	t.Run("ident and dot", func(t *testing.T) {
 		s := newScanner("provider.name")
 
@@ -0,0 +1,172 @@
+package fields
+
+import "strings"
+
+// For all functions in this file, a field with empty SubFields means the whole subtree of that field,
+// the same as the generated ComputeKeepFunc interprets it. In the same way, an empty list of fields
+// or a list containing the wildcard field means the whole message.
+
+func findField(fieldInfos []FieldInfo, name string) (FieldInfo, bool) {
+	for _, f := range fieldInfos {
+		if f.FieldName == name {
+			return f, true
+		}
+	}
+	return FieldInfo{}, false
+}
+
+// isWhole checks whether the fields mean the whole message (or the whole subtree of a field)
+func isWhole(fieldInfos []FieldInfo) bool {
+	return len(fieldInfos) == 0 || HasWildcard(fieldInfos)
+}
+
+// Union returns the fields that are in a or in b.
+// The order of fields in a is kept, fields only in b are appended after them.
+// Returns the whole message (a or b unchanged) when a or b is the whole message
+func Union(a []FieldInfo, b []FieldInfo) []FieldInfo {
+	switch {
+	case isWhole(a):
+		return a
+	case isWhole(b):
+		return b
+	default:
+		return mergeFieldInfos(a, b)
+	}
+}
+
+// mergeFieldInfos returns the union of fields that are not the whole message
+func mergeFieldInfos(a []FieldInfo, b []FieldInfo) []FieldInfo {
+	result := make([]FieldInfo, 0, len(a)+len(b))
+	for _, f := range a {
+		other, ok := findField(b, f.FieldName)
+		if !ok {
+			result = append(result, f)
+			continue
+		}
+		result = append(result, FieldInfo{
+			FieldName: f.FieldName,
+			SubFields: Union(f.SubFields, other.SubFields),
+		})
+	}
+
+	for _, f := range b {
+		if _, ok := findField(a, f.FieldName); !ok {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// Intersect returns the fields that are in both a and b, with the order of fields in a.
+// empty is true when a and b do not have any common fields, because an empty result would mean the whole message
+func Intersect(a []FieldInfo, b []FieldInfo) (result []FieldInfo, empty bool) {
+	switch {
+	case isWhole(a):
+		return b, false
+	case isWhole(b):
+		return a, false
+	}
+
+	result = make([]FieldInfo, 0, len(a))
+	for _, f := range a {
+		other, ok := findField(b, f.FieldName)
+		if !ok {
+			continue
+		}
+
+		subFields, subEmpty := Intersect(f.SubFields, other.SubFields)
+		if subEmpty {
+			continue
+		}
+		result = append(result, FieldInfo{
+			FieldName: f.FieldName,
+			SubFields: subFields,
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, true
+	}
+	return result, false
+}
+
+// Subtract returns the fields that are in a but not in b, with the order of fields in a.
+// empty is true when all fields of a are in b, because an empty result would mean the whole message.
+// Returns an error when a part of a whole subtree (or of the whole message) in a is subtracted,
+// because the result can not be computed without knowing the message type
+func Subtract(a []FieldInfo, b []FieldInfo) (result []FieldInfo, empty bool, err error) {
+	switch {
+	case isWhole(b):
+		return nil, true, nil
+	case isWhole(a):
+		return nil, false, ErrSubtractFromWholeField("")
+	}
+
+	result = make([]FieldInfo, 0, len(a))
+	for _, f := range a {
+		other, ok := findField(b, f.FieldName)
+		if !ok {
+			result = append(result, f)
+			continue
+		}
+
+		subFields, subEmpty, err := Subtract(f.SubFields, other.SubFields)
+		if err != nil {
+			return nil, false, PrependParentField(err, f.FieldName)
+		}
+		if subEmpty {
+			continue
+		}
+		result = append(result, FieldInfo{
+			FieldName: f.FieldName,
+			SubFields: subFields,
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, true, nil
+	}
+	return result, false, nil
+}
+
+// Contains checks whether all fields in b are also in a
+func Contains(a []FieldInfo, b []FieldInfo) bool {
+	switch {
+	case isWhole(a):
+		return true
+	case isWhole(b):
+		return false
+	}
+
+	for _, f := range b {
+		other, ok := findField(a, f.FieldName)
+		if !ok {
+			return false
+		}
+		if !Contains(other.SubFields, f.SubFields) {
+			return false
+		}
+	}
+	return true
+}
+
+// SubTree returns the sub fields of the field at path (field names separated by '.').
+// ok is false when the field is not in fieldInfos, and the result is empty when the field is selected as a whole,
+// including when it is selected by a wildcard field
+func SubTree(fieldInfos []FieldInfo, path string) (subFields []FieldInfo, ok bool) {
+	for _, name := range strings.Split(path, ".") {
+		if HasWildcard(fieldInfos) {
+			return nil, true
+		}
+
+		field, found := findField(fieldInfos, name)
+		if !found {
+			return nil, false
+		}
+		if len(field.SubFields) == 0 {
+			return nil, true
+		}
+		fieldInfos = field.SubFields
+	}
+	return fieldInfos, true
+}
@@ -0,0 +1,278 @@
+package fields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustComputeFieldInfos(fields ...string) []FieldInfo {
+	infos, err := ComputeFieldInfos(fields)
+	if err != nil {
+		panic(err)
+	}
+	return infos
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("empty means whole message", func(t *testing.T) {
+		assert.Equal(t, []FieldInfo(nil), Union(nil, nil))
+		assert.Equal(t, []FieldInfo(nil), Union(nil, mustComputeFieldInfos("sku")))
+		assert.Equal(t, []FieldInfo{}, Union(mustComputeFieldInfos("sku"), []FieldInfo{}))
+		assert.Equal(t, mustComputeFieldInfos("*"), Union(mustComputeFieldInfos("sku"), mustComputeFieldInfos("*")))
+	})
+
+	t.Run("disjoint", func(t *testing.T) {
+		result := Union(
+			mustComputeFieldInfos("sku", "provider.id"),
+			mustComputeFieldInfos("name"),
+		)
+		assert.Equal(t, mustComputeFieldInfos("sku", "provider.id", "name"), result)
+	})
+
+	t.Run("merge sub fields", func(t *testing.T) {
+		result := Union(
+			mustComputeFieldInfos("sku", "provider.{id|name}", "attributes.options.code"),
+			mustComputeFieldInfos("provider.{name|logo}", "attributes.{id|options.name}"),
+		)
+		assert.Equal(t, mustComputeFieldInfos(
+			"sku", "provider.{id|name|logo}", "attributes.{options.{code|name}|id}",
+		), result)
+	})
+
+	t.Run("whole subtree wins", func(t *testing.T) {
+		result := Union(
+			mustComputeFieldInfos("sku", "provider.{id|name}"),
+			mustComputeFieldInfos("provider"),
+		)
+		assert.Equal(t, mustComputeFieldInfos("sku", "provider"), result)
+
+		result = Union(
+			mustComputeFieldInfos("provider"),
+			mustComputeFieldInfos("provider.id"),
+		)
+		assert.Equal(t, mustComputeFieldInfos("provider"), result)
+	})
+}
+
+func TestIntersect(t *testing.T) {
+	t.Run("disjoint", func(t *testing.T) {
+		result, empty := Intersect(
+			mustComputeFieldInfos("sku", "provider.id"),
+			mustComputeFieldInfos("name"),
+		)
+		assert.Equal(t, true, empty)
+		assert.Nil(t, result)
+
+		result, empty = Intersect(
+			mustComputeFieldInfos("provider.id"),
+			mustComputeFieldInfos("provider.name"),
+		)
+		assert.Equal(t, true, empty)
+		assert.Nil(t, result)
+	})
+
+	t.Run("empty means whole message", func(t *testing.T) {
+		result, empty := Intersect(nil, mustComputeFieldInfos("sku"))
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku"), result)
+
+		result, empty = Intersect(mustComputeFieldInfos("sku"), nil)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku"), result)
+
+		result, empty = Intersect(nil, nil)
+		assert.Equal(t, false, empty)
+		assert.Nil(t, result)
+	})
+
+	t.Run("with wildcard", func(t *testing.T) {
+		result, empty := Intersect(
+			mustComputeFieldInfos("*"),
+			mustComputeFieldInfos("sku", "provider.id"),
+		)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku", "provider.id"), result)
+
+		result, empty = Intersect(
+			mustComputeFieldInfos("sku", "provider.{id|name}"),
+			mustComputeFieldInfos("provider.*"),
+		)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("provider.{id|name}"), result)
+	})
+
+	t.Run("common fields", func(t *testing.T) {
+		result, empty := Intersect(
+			mustComputeFieldInfos("sku", "provider.{id|name}", "attributes.options.code"),
+			mustComputeFieldInfos("provider.{name|logo}", "sku", "name"),
+		)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku", "provider.name"), result)
+	})
+
+	t.Run("with whole subtree", func(t *testing.T) {
+		result, empty := Intersect(
+			mustComputeFieldInfos("provider", "attributes.options.code"),
+			mustComputeFieldInfos("provider.{id|name}", "attributes"),
+		)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("provider.{id|name}", "attributes.options.code"), result)
+	})
+
+	t.Run("empty sub fields after intersect is removed", func(t *testing.T) {
+		result, empty := Intersect(
+			mustComputeFieldInfos("sku", "provider.id"),
+			mustComputeFieldInfos("sku", "provider.name"),
+		)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku"), result)
+	})
+}
+
+func TestSubtract(t *testing.T) {
+	t.Run("disjoint", func(t *testing.T) {
+		result, empty, err := Subtract(
+			mustComputeFieldInfos("sku", "provider.id"),
+			mustComputeFieldInfos("name"),
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku", "provider.id"), result)
+	})
+
+	t.Run("remove sub fields", func(t *testing.T) {
+		result, empty, err := Subtract(
+			mustComputeFieldInfos("sku", "provider.{id|name}", "attributes.options.{code|name}"),
+			mustComputeFieldInfos("provider.name", "attributes.options.{code|name}", "sku"),
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("provider.id"), result)
+	})
+
+	t.Run("remove whole subtree", func(t *testing.T) {
+		result, empty, err := Subtract(
+			mustComputeFieldInfos("sku", "provider.{id|name}"),
+			mustComputeFieldInfos("provider"),
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, empty)
+		assert.Equal(t, mustComputeFieldInfos("sku"), result)
+	})
+
+	t.Run("all fields removed", func(t *testing.T) {
+		result, empty, err := Subtract(
+			mustComputeFieldInfos("sku", "provider.id"),
+			mustComputeFieldInfos("sku", "provider"),
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, empty)
+		assert.Nil(t, result)
+
+		result, empty, err = Subtract(mustComputeFieldInfos("sku"), nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, empty)
+		assert.Nil(t, result)
+
+		result, empty, err = Subtract(mustComputeFieldInfos("provider.id"), mustComputeFieldInfos("provider.*"))
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, empty)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error when subtract from whole message", func(t *testing.T) {
+		result, empty, err := Subtract(nil, mustComputeFieldInfos("sku"))
+		assert.Equal(t, ErrSubtractFromWholeField(""), err)
+		assert.Equal(t, "fieldmask: can not subtract sub fields from the whole message", err.Error())
+		assert.Equal(t, false, empty)
+		assert.Nil(t, result)
+
+		_, _, err = Subtract(mustComputeFieldInfos("provider.*"), mustComputeFieldInfos("provider.id"))
+		assert.Equal(t, ErrSubtractFromWholeField("provider"), err)
+	})
+
+	t.Run("error when subtract from whole subtree", func(t *testing.T) {
+		result, empty, err := Subtract(
+			mustComputeFieldInfos("sku", "attributes.options"),
+			mustComputeFieldInfos("attributes.options.code"),
+		)
+		assert.Equal(t, ErrSubtractFromWholeField("attributes.options"), err)
+		assert.Equal(t,
+			"fieldmask: can not subtract sub fields from the whole field 'attributes.options'",
+			err.Error(),
+		)
+		assert.Equal(t, false, empty)
+		assert.Nil(t, result)
+	})
+}
+
+func TestContains(t *testing.T) {
+	a := mustComputeFieldInfos("sku", "provider.{id|name}", "attributes")
+
+	assert.Equal(t, true, Contains(a, a))
+	assert.Equal(t, true, Contains(a, mustComputeFieldInfos("sku", "provider.id")))
+	assert.Equal(t, true, Contains(a, mustComputeFieldInfos("attributes.options.code")))
+
+	assert.Equal(t, false, Contains(a, mustComputeFieldInfos("name")))
+	assert.Equal(t, false, Contains(a, mustComputeFieldInfos("provider")))
+	assert.Equal(t, false, Contains(a, mustComputeFieldInfos("provider.logo")))
+
+	// empty means the whole message
+	assert.Equal(t, true, Contains(nil, mustComputeFieldInfos("sku")))
+	assert.Equal(t, true, Contains(nil, nil))
+	assert.Equal(t, false, Contains(a, nil))
+	assert.Equal(t, true, Contains(mustComputeFieldInfos("*"), a))
+	assert.Equal(t, false, Contains(a, mustComputeFieldInfos("*")))
+	assert.Equal(t, true, Contains(mustComputeFieldInfos("provider.*"), mustComputeFieldInfos("provider.id")))
+}
+
+func TestSubTree(t *testing.T) {
+	infos := mustComputeFieldInfos("sku", "provider.{id|name}", "attributes.options.*", "variants.*")
+
+	t.Run("sub fields", func(t *testing.T) {
+		result, ok := SubTree(infos, "provider")
+		assert.Equal(t, true, ok)
+		assert.Equal(t, mustComputeFieldInfos("id", "name"), result)
+	})
+
+	t.Run("nested path", func(t *testing.T) {
+		result, ok := SubTree(infos, "attributes.options")
+		assert.Equal(t, true, ok)
+		assert.Equal(t, mustComputeFieldInfos("*"), result)
+	})
+
+	t.Run("whole field", func(t *testing.T) {
+		result, ok := SubTree(infos, "sku")
+		assert.Equal(t, true, ok)
+		assert.Nil(t, result)
+
+		result, ok = SubTree(mustComputeFieldInfos("provider"), "provider.id")
+		assert.Equal(t, true, ok)
+		assert.Nil(t, result)
+	})
+
+	t.Run("selected by wildcard", func(t *testing.T) {
+		result, ok := SubTree(infos, "variants.sku")
+		assert.Equal(t, true, ok)
+		assert.Nil(t, result)
+
+		result, ok = SubTree(mustComputeFieldInfos("*", "provider.id"), "provider")
+		assert.Equal(t, true, ok)
+		assert.Nil(t, result)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		result, ok := SubTree(infos, "name")
+		assert.Equal(t, false, ok)
+		assert.Nil(t, result)
+
+		result, ok = SubTree(infos, "provider.logo")
+		assert.Equal(t, false, ok)
+		assert.Nil(t, result)
+
+		result, ok = SubTree(nil, "sku")
+		assert.Equal(t, false, ok)
+		assert.Nil(t, result)
+	})
+}
@@ -15,43 +15,67 @@ import (
 var fieldmaskTemplateString string
 
 type typeAndNewFunc struct {
+	IsInput             bool
 	StructName          string
-	FuncType            string
+	MaskTypeName        string
 	ModifyOptionsStmt   string
-	ComputeKeepFuncName string
+	ComputeMaskFuncName string
+	KeepFuncName        string
+	KeepIntoFuncName    string
+	ApplyFuncName       string
+	ExcludeMaskName     string
+	ComputeIncludedName string
+	NormalizeFuncName   string
+	ToProtoNamesName    string
 	QualifiedType       string
+	PathsVarName        string
+	PathsTypeName       string
+	HasPathFuncName     string
+	SelectionTypeName   string
+	Accessors           []selectionMethod
+	SubMaskMethods      []selectionMethod
 }
 
-type keepFunc struct {
-	TypeName string
-	FuncName string
-	FuncType string
+type maskType struct {
+	TypeName            string
+	MaskTypeName        string
+	BitWords            int
+	SubMasks            []subMaskField
+	KeyFields           []string
+	ComputeMaskFuncName string
+	KeepFuncName        string
+	QualifiedType       string
 
-	FieldFuncs     []fieldKeepFunc
-	FieldFuncImpls []fieldFuncImpl
-}
+	AllFieldsVarName string
+	AllJSONNames     []string
 
-type fieldFuncImpl struct {
-	QualifiedType string
-	FuncName      string
-	FieldName     string
+	ComputeCases []computeMaskCase
+	KeepStmts    []string
 }
 
-type fieldKeepFunc struct {
-	JSONName   string
-	AppendStmt string
+type subMaskField struct {
+	Name         string
+	MaskTypeName string
+}
 
-	fieldName string // is private
-	funcName  string // is private
-	isObject  bool
+type computeMaskCase struct {
+	CaseNames string
+	Stmt      string
 }
 
 type generateParams struct {
-	PackageName      string
-	Imports          []string
-	TypeAndNewFuncs  []typeAndNewFunc
-	KeepFuncs        []keepFunc
-	KeepFuncsForImpl []keepFunc
+	PackageName     string
+	Imports         []string
+	TypeAndNewFuncs []typeAndNewFunc
+	MaskTypes       []maskType
+	KeepIntoFuncs   []keepIntoFunc
+	ApplyFuncs      []applyFunc
+	ExcludeFuncs    []excludeFunc
+	NormalizeFuncs  []normalizeFunc
+	ToProtoFuncs    []toProtoNamesFunc
+	PathBuilders    []pathBuilder
+	Selections      []selection
+	StructFuncs     []structFunc
 }
 
 func mapSlice[A any, B any](input []A, fn func(a A) B) []B {
@@ -62,26 +86,18 @@ func mapSlice[A any, B any](input []A, fn func(a A) B) []B {
 	return result
 }
 
-func filterSlice[T any](input []T, fn func(a T) bool) []T {
-	result := make([]T, 0, len(input))
-	for _, e := range input {
-		pred := fn(e)
-		if !pred {
-			continue
-		}
-		result = append(result, e)
-	}
-	return result
-}
-
-func computeImports(infos []*objectInfo) []string {
+func computeImports(infos []*objectInfo, local localPackage) []string {
 	var result []string
 	importedPaths := map[string]string{}
+	if local.importPath != "" {
+		importedPaths[local.importPath] = local.name
+	}
 
 	for _, info := range infos {
 		oldAlias, existed := importedPaths[info.importPath]
 		if existed {
 			info.alias = oldAlias
+			info.isLocal = info.importPath == local.importPath
 			continue
 		}
 
@@ -100,186 +116,378 @@ func computeImports(infos []*objectInfo) []string {
 	return result
 }
 
-func getComputeKeepFuncName(e *objectInfo) string {
-	return fmt.Sprintf("%s_%s_ComputeKeepFunc", e.alias, e.typeName)
+func getMaskTypeName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_Mask", e.alias, e.typeName)
+}
+
+func getComputeMaskFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_ComputeMask", e.alias, e.typeName)
+}
+
+func getKeepFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_Keep", e.alias, e.typeName)
 }
 
 func getQualifiedTypeName(e *objectInfo) string {
+	if e.isLocal {
+		return e.typeName
+	}
 	return fmt.Sprintf("%s.%s", e.alias, e.typeName)
 }
 
-func getFuncTypeSignature(e *objectInfo) string {
-	typeName := getQualifiedTypeName(e)
-	return fmt.Sprintf("func (newMsg *%s, msg *%s)", typeName, typeName)
+func getOneofWrapperTypeName(e *objectInfo, oneof *oneofInfo) string {
+	if e.isLocal {
+		return oneof.wrapperType
+	}
+	return fmt.Sprintf("%s.%s", e.alias, oneof.wrapperType)
+}
+
+// getBitExpr returns the expression checking the bit of the field at index in the mask m
+func getBitExpr(index int) string {
+	return getBitExprOf("m", index)
 }
 
-func getKeepFuncStmt(funcName string, parentField string) string {
+func getSetBitStmt(index int) string {
+	return fmt.Sprintf("m.bits[%d] |= 1 << %d", index/64, index%64)
+}
+
+// computeMaskStmtForObject compiles the sub fields of a message field,
+// a wildcard in the sub fields selects the whole field, as in fields.SubTree
+func computeMaskStmtForObject(index int, field objectField) string {
 	result := fmt.Sprintf(`
 isSimpleField = false
-keepFunc, err := %s(field.SubFields)
+subMask, err := %s(field.SubFields)
 if err != nil {
 	return nil, fields.PrependParentField(err, "%s")
 }
-`, funcName, parentField)
+if fields.HasWildcard(field.SubFields) {
+	subMask = nil
+}
+%s
+m.%s = subMask
+`,
+		getComputeMaskFuncName(field.info),
+		field.jsonName,
+		getSetBitStmt(index),
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func keepStmtForObject(field objectField) string {
+	result := fmt.Sprintf(`
+if msg.%s != nil {
+	newMsg.%s = &%s{}
+	%s(m.%s, newMsg.%s, msg.%s)
+}
+`,
+		field.name,
+		field.name, getQualifiedTypeName(field.info),
+		getKeepFuncName(field.info), field.name, field.name, field.name,
+	)
 	return strings.TrimSpace(result)
 }
 
-func appendStmtForObject(obj *objectInfo, field objectField) string {
-	objectType := getQualifiedTypeName(obj)
+func keepStmtForArrayOfObjects(field objectField) string {
 	subObjectType := getQualifiedTypeName(field.info)
-	funcName := getComputeKeepFuncName(field.info)
 
 	result := fmt.Sprintf(`
-%s
-subFuncs = append(subFuncs, func (newMsg *%s, msg *%s) {
-	if msg.%s == nil {
-		return
+msgList := make([]*%s, 0, len(msg.%s))
+for _, e := range msg.%s {
+	if e == nil {
+		msgList = append(msgList, nil)
+		continue
 	}
 	newSubMsg := &%s{}
-	keepFunc(newSubMsg, msg.%s)
-	newMsg.%s = newSubMsg
-})
+	%s(m.%s, newSubMsg, e)
+	msgList = append(msgList, newSubMsg)
+}
+newMsg.%s = msgList
 `,
-		getKeepFuncStmt(funcName, field.jsonName),
-		objectType, objectType,
+		subObjectType, field.name,
 		field.name,
 		subObjectType,
-		field.name, field.name,
+		getKeepFuncName(field.info), field.name,
+		field.name,
 	)
-
 	return strings.TrimSpace(result)
 }
 
-func appendStmtForArrayOfObjects(obj *objectInfo, field objectField) string {
-	objectType := getQualifiedTypeName(obj)
+func keepStmtForMapOfObjects(field objectField) string {
 	subObjectType := getQualifiedTypeName(field.info)
-	funcName := getComputeKeepFuncName(field.info)
 
 	result := fmt.Sprintf(`
-%s
-subFuncs = append(subFuncs, func(newMsg *%s, msg *%s) {
-	msgList := make([]*%s, 0, len(msg.%s))
-	for _, e := range msg.%s {
-		newSubMsg := &%s{}
-		keepFunc(newSubMsg, e)
-		msgList = append(msgList, newSubMsg)
+msgMap := make(map[%s]*%s, len(msg.%s))
+for k, e := range msg.%s {
+	if e == nil {
+		msgMap[k] = nil
+		continue
 	}
-	newMsg.%s = msgList
-})
+	newSubMsg := &%s{}
+	%s(m.%s, newSubMsg, e)
+	msgMap[k] = newSubMsg
+}
+newMsg.%s = msgMap
 `,
-		getKeepFuncStmt(funcName, field.jsonName),
-		objectType, objectType,
+		field.mapKeyType, subObjectType, field.name,
+		field.name,
 		subObjectType,
-		field.name, field.name,
-		subObjectType, field.name,
+		getKeepFuncName(field.info), field.name,
+		field.name,
 	)
+	return strings.TrimSpace(result)
+}
+
+func keepStmtForOneofObject(obj *objectInfo, field objectField) string {
+	wrapperType := getOneofWrapperTypeName(obj, field.oneof)
 
+	result := fmt.Sprintf(`
+if wrapper, ok := msg.%s.(*%s); ok && wrapper.%s != nil {
+	newSubMsg := &%s{}
+	%s(m.%s, newSubMsg, wrapper.%s)
+	newMsg.%s = &%s{%s: newSubMsg}
+}
+`,
+		field.oneof.fieldName, wrapperType, field.name,
+		getQualifiedTypeName(field.info),
+		getKeepFuncName(field.info), field.name, field.name,
+		field.oneof.fieldName, wrapperType, field.name,
+	)
 	return strings.TrimSpace(result)
 }
 
-func buildKeepFuncForField(info *objectInfo, subField objectField) fieldKeepFunc {
-	funcName := fmt.Sprintf("%s_%s_Keep_%s", info.alias, info.typeName, subField.name)
-	isObject := false
+func keepStmtForOneof(obj *objectInfo, field objectField) string {
+	result := fmt.Sprintf(`
+if wrapper, ok := msg.%s.(*%s); ok {
+	newMsg.%s = wrapper
+}
+`,
+		field.oneof.fieldName, getOneofWrapperTypeName(obj, field.oneof),
+		field.oneof.fieldName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildKeepStmtForField(info *objectInfo, index int, subField objectField) string {
+	var stmt string
+	switch {
+	case subField.fieldType == fieldTypeObject && subField.oneof != nil:
+		stmt = keepStmtForOneofObject(info, subField)
+
+	case subField.fieldType == fieldTypeObject:
+		stmt = keepStmtForObject(subField)
+
+	case subField.fieldType == fieldTypeArrayOfObjects:
+		stmt = keepStmtForArrayOfObjects(subField)
 
-	var appendStmt string
-	switch subField.fieldType {
-	case fieldTypeObject:
-		appendStmt = appendStmtForObject(info, subField)
-		isObject = true
+	case subField.fieldType == fieldTypeMapOfObjects:
+		stmt = keepStmtForMapOfObjects(subField)
 
-	case fieldTypeArrayOfObjects:
-		appendStmt = appendStmtForArrayOfObjects(info, subField)
-		isObject = true
+	case subField.fieldType == fieldTypeStruct:
+		stmt = fmt.Sprintf("newMsg.%s = %s(m.%s, msg.%s)",
+			subField.name, getKeepKeysFuncName(subField.structInfo), subField.name, subField.name,
+		)
+
+	case subField.oneof != nil:
+		stmt = keepStmtForOneof(info, subField)
 
 	default:
-		appendStmt = fmt.Sprintf("subFuncs = append(subFuncs, %s)", funcName)
+		stmt = fmt.Sprintf("newMsg.%s = msg.%s", subField.name, subField.name)
 	}
 
-	return fieldKeepFunc{
-		JSONName:   subField.jsonName,
-		AppendStmt: appendStmt,
+	return fmt.Sprintf("if %s {\n%s\n}", getBitExpr(index), stmt)
+}
 
-		fieldName: subField.name,
-		funcName:  funcName,
-		isObject:  isObject,
+func buildComputeMaskCase(index int, subField objectField) computeMaskCase {
+	stmt := getSetBitStmt(index)
+	if subField.info != nil {
+		stmt = computeMaskStmtForObject(index, subField)
+	}
+	if subField.fieldType == fieldTypeStruct {
+		stmt = computeMaskStmtForStruct(index, subField)
+	}
+	return computeMaskCase{
+		CaseNames: getCaseNames(subField),
+		Stmt:      stmt,
 	}
 }
 
-func buildFieldFuncImplList(info *objectInfo, fieldFuncs []fieldKeepFunc) []fieldFuncImpl {
-	implFuncs := make([]fieldFuncImpl, 0)
-	for _, fn := range fieldFuncs {
-		if fn.isObject {
-			continue
-		}
-		implFuncs = append(implFuncs, fieldFuncImpl{
-			QualifiedType: getQualifiedTypeName(info),
-			FieldName:     fn.fieldName,
-			FuncName:      fn.funcName,
-		})
-	}
-	return implFuncs
+// getAllFieldsVarName returns the name of the variable containing all direct fields, for expanding the wildcard
+func getAllFieldsVarName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_AllFields", e.alias, e.typeName)
 }
 
-func buildKeepFunc(info *objectInfo) keepFunc {
-	fieldFuncs := mapSlice(info.subFields, func(subField objectField) fieldKeepFunc {
-		return buildKeepFuncForField(info, subField)
-	})
+func buildMaskType(info *objectInfo) maskType {
+	var subMasks []subMaskField
+	var keyFields []string
+	computeCases := make([]computeMaskCase, 0, len(info.subFields))
+	keepStmts := make([]string, 0, len(info.subFields))
+
+	for index, subField := range info.subFields {
+		if subField.info != nil {
+			subMasks = append(subMasks, subMaskField{
+				Name:         subField.name,
+				MaskTypeName: getMaskTypeName(subField.info),
+			})
+		}
+		if subField.fieldType == fieldTypeStruct {
+			keyFields = append(keyFields, subField.name)
+		}
+		computeCases = append(computeCases, buildComputeMaskCase(index, subField))
+		keepStmts = append(keepStmts, buildKeepStmtForField(info, index, subField))
+	}
 
-	return keepFunc{
-		TypeName:       info.typeName,
-		FuncName:       getComputeKeepFuncName(info),
-		FuncType:       getFuncTypeSignature(info),
-		FieldFuncs:     fieldFuncs,
-		FieldFuncImpls: buildFieldFuncImplList(info, fieldFuncs),
+	return maskType{
+		TypeName:            info.typeName,
+		MaskTypeName:        getMaskTypeName(info),
+		BitWords:            (len(info.subFields) + 63) / 64,
+		SubMasks:            subMasks,
+		KeyFields:           keyFields,
+		ComputeMaskFuncName: getComputeMaskFuncName(info),
+		KeepFuncName:        getKeepFuncName(info),
+		QualifiedType:       getQualifiedTypeName(info),
+
+		AllFieldsVarName: getAllFieldsVarName(info),
+		AllJSONNames: mapSlice(info.subFields, func(subField objectField) string {
+			return subField.jsonName
+		}),
+
+		ComputeCases: computeCases,
+		KeepStmts:    keepStmts,
 	}
 }
 
-func writeToTemplate(writer io.Writer, templateStr string, params any) {
+func writeToTemplate(writer io.Writer, templateStr string, params any) error {
 	tmpl, err := template.New("fieldmask").Parse(templateStr)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("fieldmask: parse template: %w", err)
 	}
 
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, params)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("fieldmask: execute template: %w", err)
 	}
 
 	sourceData, err := format.Source(buf.Bytes())
 	if err != nil {
-		fmt.Println(buf.String())
-		panic(err)
+		return &FormatError{Source: buf.Bytes(), Err: err}
 	}
 
 	_, err = writer.Write(sourceData)
-	if err != nil {
-		panic(err)
+	return err
+}
+
+// writeGeneratedFile writes the file only when the generation succeeds, to not leave a broken file
+func writeGeneratedFile(fileName string, generate func(writer io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := generate(&buf); err != nil {
+		return err
 	}
+	return os.WriteFile(fileName, buf.Bytes(), 0o644)
+}
+
+// localPackage is the package the generated code belongs to,
+// types of this package are referenced without an import
+type localPackage struct {
+	name       string
+	importPath string
+}
+
+// fileContent is the content of a generated file: the constructors of the input messages,
+// and the helper types and functions of the helper messages and the struct types.
+// The helper messages in fieldMaskSet also have the XxxFieldMask types
+type fileContent struct {
+	inputInfos   []*objectInfo
+	helperInfos  []*objectInfo
+	structInfos  []*objectInfo
+	fieldMaskSet map[objectKey]struct{}
+	imports      []string
+}
+
+// computeFieldMaskSet returns the messages having the XxxFieldMask types: the input messages,
+// and the other messages for the field masks of the sub messages, when their names are not used yet
+func computeFieldMaskSet(inputInfos []*objectInfo, infos []*objectInfo) map[objectKey]struct{} {
+	result := map[objectKey]struct{}{}
+	usedNames := map[string]struct{}{}
+	for _, info := range inputInfos {
+		result[info.getKey()] = struct{}{}
+		usedNames[getFieldMaskStructName(info)] = struct{}{}
+	}
+	for _, info := range infos {
+		name := getFieldMaskStructName(info)
+		if _, existed := usedNames[name]; existed {
+			continue
+		}
+		usedNames[name] = struct{}{}
+		result[info.getKey()] = struct{}{}
+	}
+	return result
+}
+
+// computeFileImports returns the imports of the types used by the helpers, with the already computed aliases
+func computeFileImports(helperInfos []*objectInfo, structInfos []*objectInfo) []string {
+	var result []string
+	imported := map[string]struct{}{}
+	addImport := func(info *objectInfo) {
+		if info.isLocal {
+			return
+		}
+		if _, existed := imported[info.importPath]; existed {
+			return
+		}
+		imported[info.importPath] = struct{}{}
+		result = append(result, fmt.Sprintf("%s %q", info.alias, info.importPath))
+	}
+
+	for _, info := range helperInfos {
+		addImport(info)
+		for _, field := range info.subFields {
+			if field.info != nil {
+				addImport(field.info)
+			}
+		}
+	}
+	for _, info := range structInfos {
+		addImport(info)
+	}
+	return result
 }
 
 func generateCode(
 	writer io.Writer, inputInfos []*objectInfo,
-	packageName string,
-) {
+	local localPackage,
+) error {
 	infos := traverseAllObjectInfos(inputInfos)
+	structInfos := collectStructInfos(infos)
+
+	return generateFileCode(writer, fileContent{
+		inputInfos:   inputInfos,
+		helperInfos:  infos,
+		structInfos:  structInfos,
+		fieldMaskSet: computeFieldMaskSet(inputInfos, infos),
+		imports:      computeImports(append(infos[:len(infos):len(infos)], structInfos...), local),
+	}, local)
+}
+
+func generateFileCode(writer io.Writer, content fileContent, local localPackage) error {
+	infos := content.helperInfos
 
 	inputSet := map[objectKey]struct{}{}
-	for _, obj := range inputInfos {
+	for _, obj := range content.inputInfos {
 		inputSet[obj.getKey()] = struct{}{}
 	}
 
-	inputOnlyInfos := make([]*objectInfo, 0)
+	fieldMaskInfos := make([]*objectInfo, 0)
 	for _, info := range infos {
-		_, ok := inputSet[info.getKey()]
+		_, ok := content.fieldMaskSet[info.getKey()]
 		if ok {
-			inputOnlyInfos = append(inputOnlyInfos, info)
+			fieldMaskInfos = append(fieldMaskInfos, info)
 		}
 	}
 
-	imports := computeImports(infos)
-
-	typeAndNewFuncs := mapSlice(inputOnlyInfos, func(e *objectInfo) typeAndNewFunc {
+	typeAndNewFuncs := mapSlice(fieldMaskInfos, func(e *objectInfo) typeAndNewFunc {
+		_, isInput := inputSet[e.getKey()]
 		modifyOptions := ""
 		if e.opts.enableLimitedTo() {
 			fieldList := strings.Join(mapSlice(e.opts.limitedTo, func(s string) string {
@@ -296,46 +504,71 @@ options = append(opts, options...)
 `, fieldList)
 		}
 
+		accessors := buildFieldMaskAccessors(e)
 		return typeAndNewFunc{
-			StructName:          e.typeName + "FieldMask",
-			FuncType:            getFuncTypeSignature(e),
+			IsInput:             isInput,
+			StructName:          getFieldMaskStructName(e),
+			MaskTypeName:        getMaskTypeName(e),
 			ModifyOptionsStmt:   modifyOptions,
-			ComputeKeepFuncName: getComputeKeepFuncName(e),
+			ComputeMaskFuncName: getComputeMaskFuncName(e),
+			KeepFuncName:        getKeepFuncName(e),
+			KeepIntoFuncName:    getKeepIntoFuncName(e),
+			ApplyFuncName:       getApplyFuncName(e),
+			ExcludeMaskName:     e.typeName + "ExcludeMask",
+			ComputeIncludedName: getComputeIncludedFieldsFuncName(e),
+			NormalizeFuncName:   getNormalizeFuncName(e),
+			ToProtoNamesName:    getToProtoNamesFuncName(e),
 			QualifiedType:       getQualifiedTypeName(e),
+			PathsVarName:        getPathsVarName(e),
+			PathsTypeName:       getPathBuilderTypeName(e),
+			HasPathFuncName:     getHasPathFuncName(e),
+			SelectionTypeName:   getSelectionTypeName(e),
+			Accessors:           accessors,
+			SubMaskMethods:      buildSubMaskMethods(e, content.fieldMaskSet, accessors),
 		}
 	})
 
-	keepFuncs := mapSlice(infos, buildKeepFunc)
-	keepFuncsForImpl := filterSlice(keepFuncs, func(a keepFunc) bool {
-		return len(a.FieldFuncImpls) > 0
-	})
-
 	params := generateParams{
-		PackageName:      packageName,
-		Imports:          imports,
-		TypeAndNewFuncs:  typeAndNewFuncs,
-		KeepFuncs:        keepFuncs,
-		KeepFuncsForImpl: keepFuncsForImpl,
+		PackageName:     local.name,
+		Imports:         content.imports,
+		TypeAndNewFuncs: typeAndNewFuncs,
+		MaskTypes:       mapSlice(infos, buildMaskType),
+		KeepIntoFuncs:   mapSlice(infos, buildKeepIntoFunc),
+		ApplyFuncs:      mapSlice(infos, buildApplyFunc),
+		ExcludeFuncs:    mapSlice(infos, buildExcludeFunc),
+		NormalizeFuncs:  mapSlice(infos, buildNormalizeFunc),
+		ToProtoFuncs:    mapSlice(infos, buildToProtoNamesFunc),
+		PathBuilders:    mapSlice(infos, buildPathBuilder),
+		Selections:      mapSlice(infos, buildSelection),
+		StructFuncs:     buildStructFuncs(content.structInfos),
 	}
 
-	writeToTemplate(writer, fieldmaskTemplateString, params)
+	return writeToTemplate(writer, fieldmaskTemplateString, params)
 }
 
-// Generate ...
+// Generate writes the generated field masks to the file, panics on errors
 func Generate(
 	fileName string,
 	protoMessages []ProtoMessage,
 	packageName string,
+	options ...GenerateOption,
 ) {
-	file, err := os.Create(fileName)
+	err := writeGeneratedFile(fileName, func(writer io.Writer) error {
+		return GenerateTo(writer, protoMessages, packageName, options...)
+	})
 	if err != nil {
 		panic(err)
 	}
+}
 
-	generateCode(file, parseMessages(protoMessages...), packageName)
-
-	err = file.Close()
+// GenerateTo writes the generated field masks to the writer.
+// All problems of the input messages are returned together as GenerateErrors
+func GenerateTo(
+	writer io.Writer, protoMessages []ProtoMessage, packageName string, options ...GenerateOption,
+) error {
+	infos, err := parseMessagesWithOptions(protoMessages, computeGenerateOptions(options))
 	if err != nil {
-		panic(err)
+		return err
 	}
+	return generateCode(writer, infos, localPackage{name: packageName})
 }
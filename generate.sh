@@ -2,5 +2,9 @@ protoc -I./testdata \
   --gofast_out=\
 Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,\
 Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types,\
+paths=source_relative:./testdata/pb \
+  --fieldmask_out=\
+'Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types;types',\
+'Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types;types',\
 paths=source_relative:./testdata/pb \
   message.proto
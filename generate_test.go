@@ -3,11 +3,14 @@ package fieldmask
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/QuangTung97/fieldmask/testdata/document"
 	"github.com/QuangTung97/fieldmask/testdata/pb"
+	"github.com/QuangTung97/fieldmask/testdata/recursive"
 )
 
 //go:embed testdata/generated/provider.go
@@ -16,10 +19,13 @@ var generatedCode string
 func TestGenerate(t *testing.T) {
 	var buf bytes.Buffer
 
-	generateCode(&buf, parseMessages(
+	err := generateCode(&buf, parseMessagesForTest(t,
 		NewProtoMessage(&pb.ProviderInfo{}),
 		NewProtoMessage(&pb.Product{}),
-	), "generated")
+		NewProtoMessage(&pb.Item{}),
+		NewProtoMessage(&pb.Catalog{}),
+	), localPackage{name: "generated"})
+	assert.Equal(t, nil, err)
 
 	assert.Equal(t, generatedCode, buf.String())
 }
@@ -30,7 +36,7 @@ var generatedCodeWithLimitedFields string
 func TestGenerate_WithLimitedTo_And_WithFieldMaskName(t *testing.T) {
 	var buf bytes.Buffer
 
-	generateCode(&buf, parseMessages(
+	err := generateCode(&buf, parseMessagesForTest(t,
 		NewProtoMessage(&pb.ProviderInfo{}),
 		NewProtoMessageWithFields(&pb.Product{}, []string{
 			"sku",
@@ -38,7 +44,150 @@ func TestGenerate_WithLimitedTo_And_WithFieldMaskName(t *testing.T) {
 			"attributes.options.code",
 			"stocks",
 		}),
-	), "generated")
+	), localPackage{name: "generated"})
+	assert.Equal(t, nil, err)
 
 	assert.Equal(t, generatedCodeWithLimitedFields, buf.String())
 }
+
+func TestGetBitExpr(t *testing.T) {
+	assert.Equal(t, "m.bits[0]&(1<<0) != 0", getBitExpr(0))
+	assert.Equal(t, "m.bits[0]&(1<<63) != 0", getBitExpr(63))
+	assert.Equal(t, "m.bits[1]&(1<<0) != 0", getBitExpr(64))
+
+	assert.Equal(t, "m.bits[1] |= 1 << 2", getSetBitStmt(66))
+}
+
+func TestGetAllMaskBits(t *testing.T) {
+	assert.Nil(t, getAllMaskBits(0))
+	assert.Equal(t, []string{"0x7"}, getAllMaskBits(3))
+	assert.Equal(t, []string{"0xffffffffffffffff"}, getAllMaskBits(64))
+	assert.Equal(t, []string{"0xffffffffffffffff", "0x3"}, getAllMaskBits(66))
+}
+
+func TestGenerateTo(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := GenerateTo(&buf, []ProtoMessage{
+			NewProtoMessage(&pb.ProviderInfo{}),
+			NewProtoMessage(&pb.Product{}),
+			NewProtoMessage(&pb.Item{}),
+			NewProtoMessage(&pb.Catalog{}),
+		}, "generated")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, generatedCode, buf.String())
+	})
+
+	t.Run("returns all errors", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := GenerateTo(&buf, []ProtoMessage{
+			NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{"id", "xxyy"}),
+			NewProtoMessageWithFields(&pb.Product{}, []string{"provider.abcd"}),
+		}, "generated")
+		assert.Equal(t, GenerateErrors{
+			{MessageName: "ProviderInfo", FieldPath: "xxyy", Err: ErrLimitedToFieldNotFound},
+			{MessageName: "Product", FieldPath: "provider.abcd", Err: ErrLimitedToFieldNotFound},
+		}, err)
+		assert.Equal(t, 0, buf.Len())
+	})
+}
+
+func TestWriteToTemplate_Format_Error(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeToTemplate(&buf, "package {{ .PackageName }}\n\nfunc {", generateParams{PackageName: "generated"})
+
+	var formatErr *FormatError
+	assert.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "package generated\n\nfunc {", string(formatErr.Source))
+	assert.Equal(t, 0, buf.Len())
+}
+
+//go:embed testdata/generated/wellknown/provider.go
+var generatedCodeWithWellKnownTypes string
+
+func TestGenerateTo_WithWellKnownTypes(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateTo(&buf, []ProtoMessage{
+		NewProtoMessage(&pb.Product{}),
+		NewProtoMessage(&document.Document{}),
+	}, "wellknown", WithWellKnownTypes())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, generatedCodeWithWellKnownTypes, buf.String())
+}
+
+//go:embed testdata/generated/tree/category.go
+var generatedCodeOfRecursiveTypes string
+
+func TestGenerateTo_Recursive_Types(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateTo(&buf, []ProtoMessage{
+		NewProtoMessage(&recursive.Category{}),
+		NewProtoMessage(&recursive.Thread{}),
+	}, "tree")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, generatedCodeOfRecursiveTypes, buf.String())
+}
+
+func TestGetWholePathMethodName(t *testing.T) {
+	assert.Equal(t, "Path", getWholePathMethodName(&objectInfo{
+		subFields: []objectField{{name: "Id"}, {name: "Name"}},
+	}))
+	assert.Equal(t, "Path_", getWholePathMethodName(&objectInfo{
+		subFields: []objectField{{name: "Id"}, {name: "Path"}},
+	}))
+}
+
+func TestBuildFieldMaskAccessors(t *testing.T) {
+	info := &objectInfo{
+		typeName: "Message",
+		alias:    "pb",
+		subFields: []objectField{
+			{name: "Id", jsonName: "id"},
+			{name: "Mask", jsonName: "mask"},
+			{name: "Has", jsonName: "has"},
+		},
+	}
+	assert.Equal(t, []selectionMethod{
+		{Name: "Id", ReturnType: "bool", Body: "return fm.Selection().Id()"},
+	}, buildFieldMaskAccessors(info))
+	assert.Equal(t, "Selected", getSelectedMethodName(info))
+}
+
+func TestBuildSubMaskMethods(t *testing.T) {
+	provider := &objectInfo{typeName: "Provider", alias: "pb", importPath: "example.com/pb"}
+	other := &objectInfo{typeName: "Other", alias: "pb", importPath: "example.com/pb"}
+	info := &objectInfo{
+		typeName: "Message",
+		alias:    "pb",
+		subFields: []objectField{
+			{name: "Provider", jsonName: "provider", info: provider},
+			{name: "Other", jsonName: "other", info: other},
+			{name: "Seller", jsonName: "seller", info: provider},
+			{name: "SellerMask", jsonName: "sellerMask"},
+		},
+	}
+	fieldMaskSet := map[objectKey]struct{}{provider.getKey(): {}}
+
+	methods := buildSubMaskMethods(info, fieldMaskSet, buildFieldMaskAccessors(info))
+	assert.Equal(t, []string{"ProviderMask"}, mapSlice(methods, func(m selectionMethod) string {
+		return m.Name
+	}))
+	assert.Equal(t, "*ProviderFieldMask", methods[0].ReturnType)
+}
+
+func TestComputeFieldMaskSet(t *testing.T) {
+	product := &objectInfo{typeName: "Product", importPath: "example.com/pb"}
+	provider := &objectInfo{typeName: "Provider", importPath: "example.com/pb"}
+	otherProduct := &objectInfo{typeName: "Product", importPath: "example.com/other"}
+	otherSeller := &objectInfo{typeName: "Seller", importPath: "example.com/other"}
+
+	result := computeFieldMaskSet(
+		[]*objectInfo{product},
+		[]*objectInfo{product, provider, otherProduct, otherSeller},
+	)
+	assert.Equal(t, map[objectKey]struct{}{
+		product.getKey():     {},
+		provider.getKey():    {},
+		otherSeller.getKey(): {},
+	}, result)
+}
@@ -0,0 +1,141 @@
+// Package graphqlmask converts GraphQL selection sets parsed by gqlparser to field infos,
+// to use the generated field masks and field maps for fetching the data of GraphQL resolvers.
+package graphqlmask
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+// FragmentNotFoundError ...
+type FragmentNotFoundError struct {
+	Name string
+}
+
+func (e FragmentNotFoundError) Error() string {
+	return fmt.Sprintf("graphqlmask: fragment not found '%s'", e.Name)
+}
+
+// FragmentCycleError ...
+type FragmentCycleError struct {
+	Name string
+}
+
+func (e FragmentCycleError) Error() string {
+	return fmt.Sprintf("graphqlmask: fragment '%s' spreads itself", e.Name)
+}
+
+// FromSelectionSet converts a selection set to field infos, using the field names instead of the aliases.
+// Fragment spreads and inline fragments are expanded, fragments are found in fragments
+// when the spreads are not validated against a schema. The fields selected multiple times are merged.
+//
+// Meta fields such as __typename are skipped, a field selecting only meta fields is selected as a whole.
+// Directives such as @skip and @include are not evaluated, the fields are always selected
+func FromSelectionSet(
+	selectionSet ast.SelectionSet, fragments ast.FragmentDefinitionList,
+) ([]fields.FieldInfo, error) {
+	c := &converter{
+		fragments: fragments,
+		visiting:  map[string]struct{}{},
+	}
+	return c.convert(selectionSet)
+}
+
+// FromQuery converts the selection set of the field with the name or alias at path of the operation,
+// e.g. FromQuery(doc, "", "product") for the query '{ product(sku: "SKU01") { sku provider { id } } }'.
+// The operation name can be empty when the document contains only one operation
+func FromQuery(doc *ast.QueryDocument, operationName string, path ...string) ([]fields.FieldInfo, error) {
+	operation := doc.Operations.ForName(operationName)
+	if operation == nil {
+		return nil, fmt.Errorf("graphqlmask: operation not found '%s'", operationName)
+	}
+
+	selectionSet := operation.SelectionSet
+	for index, name := range path {
+		field := findField(selectionSet, name)
+		if field == nil {
+			return nil, fields.ErrFieldNotFound(strings.Join(path[:index+1], "."))
+		}
+		selectionSet = field.SelectionSet
+	}
+	return FromSelectionSet(selectionSet, doc.Fragments)
+}
+
+func findField(selectionSet ast.SelectionSet, name string) *ast.Field {
+	for _, selection := range selectionSet {
+		field, ok := selection.(*ast.Field)
+		if ok && field.Alias == name {
+			return field
+		}
+	}
+	return nil
+}
+
+type converter struct {
+	fragments ast.FragmentDefinitionList
+	visiting  map[string]struct{}
+}
+
+func (c *converter) convert(selectionSet ast.SelectionSet) ([]fields.FieldInfo, error) {
+	var result []fields.FieldInfo
+	for _, selection := range selectionSet {
+		infos, err := c.convertSelection(selection)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(infos) == 0:
+		case len(result) == 0:
+			// fields.Union would treat the empty result as the whole message
+			result = infos
+		default:
+			result = fields.Union(result, infos)
+		}
+	}
+	return result, nil
+}
+
+func (c *converter) convertSelection(selection ast.Selection) ([]fields.FieldInfo, error) {
+	switch s := selection.(type) {
+	case *ast.Field:
+		if strings.HasPrefix(s.Name, "__") {
+			return nil, nil
+		}
+		subFields, err := c.convert(s.SelectionSet)
+		if err != nil {
+			return nil, err
+		}
+		return []fields.FieldInfo{{FieldName: s.Name, SubFields: subFields}}, nil
+
+	case *ast.InlineFragment:
+		return c.convert(s.SelectionSet)
+
+	case *ast.FragmentSpread:
+		return c.convertFragmentSpread(s)
+
+	default:
+		return nil, nil
+	}
+}
+
+func (c *converter) convertFragmentSpread(spread *ast.FragmentSpread) ([]fields.FieldInfo, error) {
+	definition := spread.Definition
+	if definition == nil {
+		definition = c.fragments.ForName(spread.Name)
+	}
+	if definition == nil {
+		return nil, FragmentNotFoundError{Name: spread.Name}
+	}
+
+	if _, ok := c.visiting[spread.Name]; ok {
+		return nil, FragmentCycleError{Name: spread.Name}
+	}
+	c.visiting[spread.Name] = struct{}{}
+	defer delete(c.visiting, spread.Name)
+
+	return c.convert(definition.SelectionSet)
+}
@@ -0,0 +1,163 @@
+package graphqlmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/generated"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+func parseQuery(query string) *ast.QueryDocument {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}
+
+func parseAndConvert(query string, path ...string) ([]fields.FieldInfo, error) {
+	return FromQuery(parseQuery(query), "", path...)
+}
+
+func TestFromQuery(t *testing.T) {
+	t.Run("nested fields", func(t *testing.T) {
+		infos, err := parseAndConvert(`{ product(sku: "SKU01") { sku provider { id name } sellerIds } }`, "product")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{{FieldName: "id"}, {FieldName: "name"}},
+			},
+			{FieldName: "sellerIds"},
+		}, infos)
+	})
+
+	t.Run("aliases are merged", func(t *testing.T) {
+		infos, err := parseAndConvert(`{
+			item: product {
+				code: sku
+				p1: provider { id }
+				p2: provider { logo }
+				sku
+			}
+		}`, "item")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{{FieldName: "id"}, {FieldName: "logo"}},
+			},
+		}, infos)
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		infos, err := parseAndConvert(`
+			query GetProduct {
+				product {
+					...ProductFields
+					... on Product { provider { name } }
+					attributes { ... on Attribute { code } }
+				}
+			}
+
+			fragment ProductFields on Product {
+				sku
+				provider { ...ProviderFields }
+			}
+
+			fragment ProviderFields on ProviderInfo { id }
+		`, "product")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{{FieldName: "id"}, {FieldName: "name"}},
+			},
+			{
+				FieldName: "attributes",
+				SubFields: []fields.FieldInfo{{FieldName: "code"}},
+			},
+		}, infos)
+	})
+
+	t.Run("typename", func(t *testing.T) {
+		infos, err := parseAndConvert(`{ product { __typename sku provider { __typename } } }`, "product")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{FieldName: "provider"},
+		}, infos)
+	})
+
+	t.Run("operation", func(t *testing.T) {
+		infos, err := parseAndConvert(`{ sku name }`)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "sku"}, {FieldName: "name"}}, infos)
+
+		infos, err = FromQuery(parseQuery(`query A { sku } query B { name }`), "B")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "name"}}, infos)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		infos, err := parseAndConvert(`{ product { sku } }`, "product", "provider")
+		assert.Equal(t, fields.ErrFieldNotFound("product.provider"), err)
+		assert.Nil(t, infos)
+
+		infos, err = FromQuery(parseQuery(`query A { sku } query B { name }`), "")
+		assert.Equal(t, "graphqlmask: operation not found ''", err.Error())
+		assert.Nil(t, infos)
+
+		infos, err = parseAndConvert(`{ product { ...Unknown } }`, "product")
+		assert.Equal(t, FragmentNotFoundError{Name: "Unknown"}, err)
+		assert.Equal(t, "graphqlmask: fragment not found 'Unknown'", err.Error())
+		assert.Nil(t, infos)
+
+		infos, err = parseAndConvert(`
+			{ product { ...A } }
+			fragment A on Product { sku provider { ...B } }
+			fragment B on ProviderInfo { ...A }
+		`, "product")
+		assert.Equal(t, FragmentCycleError{Name: "A"}, err)
+		assert.Equal(t, "graphqlmask: fragment 'A' spreads itself", err.Error())
+		assert.Nil(t, infos)
+	})
+
+	t.Run("fragment used twice", func(t *testing.T) {
+		infos, err := parseAndConvert(`
+			{ product { p1: provider { ...P } p2: provider { ...P logo } } }
+			fragment P on ProviderInfo { id }
+		`, "product")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []fields.FieldInfo{
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{{FieldName: "id"}, {FieldName: "logo"}},
+			},
+		}, infos)
+	})
+}
+
+func TestFromSelectionSet_FieldMask(t *testing.T) {
+	infos, err := parseAndConvert(`{ product { sku provider { name } } }`, "product")
+	assert.Equal(t, nil, err)
+
+	fm, err := generated.NewProductFieldMask(fields.Format(infos))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, &pb.Product{
+		Sku:      "SKU01",
+		Provider: &pb.ProviderInfo{Name: "Provider Name"},
+	}, fm.Mask(&pb.Product{
+		Sku:        "SKU01",
+		Provider:   &pb.ProviderInfo{Id: 21, Name: "Provider Name"},
+		BrandCodes: []string{"BRAND01"},
+	}))
+}
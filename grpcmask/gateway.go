@@ -0,0 +1,88 @@
+package grpcmask
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+// DefaultQueryParam is the default query parameter of the masked fields for grpc-gateway,
+// e.g. '?fields=sku,provider.{id|name}'
+const DefaultQueryParam = "fields"
+
+// WithQueryParam sets the query parameter of the masked fields used by GatewayHandler
+func WithQueryParam(name string) Option {
+	return func(opts *maskOptions) {
+		opts.queryParam = name
+	}
+}
+
+type gatewayContextKey struct{}
+
+type gatewayMask struct {
+	metadataKey string
+	value       string
+}
+
+// GatewayHandler parses the masked fields of the query parameter once, before the grpc-gateway mux.
+// The query parameter is removed from the request, so it is not parsed again by the gateway.
+// Invalid masked fields are rejected with HTTP 400 and a body in the error format of grpc-gateway.
+// The parsed fields are sent to the gRPC server in metadata by GatewayMetadata.
+// Fields not allowed by fields.WithLimitedToFields are rejected by the Interceptor of the server,
+// because the proto names can only be checked with the message types
+func GatewayHandler(next http.Handler, options ...Option) http.Handler {
+	opts := computeOptions(options)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		maskedFields := splitFields(strings.Join(query[opts.queryParam], ","))
+		if len(maskedFields) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		fieldInfos, err := fields.ComputeFieldInfos(maskedFields, opts.fieldOptions...)
+		if err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+
+		query.Del(opts.queryParam)
+		r = r.Clone(context.WithValue(r.Context(), gatewayContextKey{}, gatewayMask{
+			metadataKey: opts.metadataKey,
+			value:       strings.Join(fields.FormatCompact(fieldInfos), ","),
+		}))
+		r.URL.RawQuery = query.Encode()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GatewayMetadata returns the metadata of the masked fields parsed by GatewayHandler,
+// used as the annotator of runtime.WithMetadata of grpc-gateway
+func GatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	mask, ok := r.Context().Value(gatewayContextKey{}).(gatewayMask)
+	if !ok {
+		return nil
+	}
+	return metadata.Pairs(mask.metadataKey, mask.value)
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	body, marshalErr := protojson.Marshal(status.New(codes.InvalidArgument, err.Error()).Proto())
+	if marshalErr != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_, _ = w.Write(body)
+}
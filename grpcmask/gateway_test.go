@@ -0,0 +1,139 @@
+package grpcmask
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/generated"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+type gatewayTest struct {
+	handler http.Handler
+
+	request *http.Request
+}
+
+func newGatewayTest(options ...Option) *gatewayTest {
+	g := &gatewayTest{}
+	g.handler = GatewayHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.request = r
+		w.WriteHeader(http.StatusOK)
+	}), options...)
+	return g
+}
+
+func (g *gatewayTest) serve(query url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/v1/products/SKU01?"+query.Encode(), nil)
+	w := httptest.NewRecorder()
+	g.handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestGatewayHandler(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		g := newGatewayTest()
+
+		w := g.serve(url.Values{
+			"fields": {"sku,provider.{name|id}", "brandCodes"},
+			"lang":   {"vi"},
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "lang=vi", g.request.URL.RawQuery)
+		assert.Equal(t, metadata.Pairs(DefaultMetadataKey, "brandCodes,provider.{id|name},sku"),
+			GatewayMetadata(context.Background(), g.request))
+	})
+
+	t.Run("custom query param and metadata key", func(t *testing.T) {
+		g := newGatewayTest(WithQueryParam("read_mask"), WithMetadataKey("x-read-mask"))
+
+		w := g.serve(url.Values{
+			"read_mask": {"provider.id"},
+			"fields":    {"sku"},
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "fields=sku", g.request.URL.RawQuery)
+		assert.Equal(t, metadata.Pairs("x-read-mask", "provider.id"),
+			GatewayMetadata(context.Background(), g.request))
+	})
+
+	t.Run("without query param", func(t *testing.T) {
+		g := newGatewayTest()
+
+		w := g.serve(url.Values{"fields": {""}})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Nil(t, GatewayMetadata(context.Background(), g.request))
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		g := newGatewayTest()
+
+		w := g.serve(url.Values{"fields": {"sku,provider.{id"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"code": 3, "message": "fields: missing '}' at the end"}`, w.Body.String())
+		assert.Nil(t, g.request)
+	})
+
+	t.Run("masked by interceptor", func(t *testing.T) {
+		g := newGatewayTest()
+		g.serve(url.Values{"fields": {"sku,provider.{logo|id}"}})
+
+		md := GatewayMetadata(context.Background(), g.request)
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		interceptor := NewInterceptor()
+		Register[*pb.Product](interceptor, getProductMethod, generated.NewProductFieldMask)
+
+		resp, err := interceptor.Unary()(ctx, &getProductRequest{Sku: "SKU01"},
+			&grpc.UnaryServerInfo{FullMethod: getProductMethod},
+			func(ctx context.Context, req any) (any, error) {
+				return newProduct("SKU01"), nil
+			},
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: &pb.ProviderInfo{Id: 21, Logo: "logo.png"},
+		}, resp)
+	})
+
+	t.Run("restricted by interceptor", func(t *testing.T) {
+		limitedTo := WithFieldOptions(fields.WithLimitedToFields([]string{"sku"}))
+
+		g := newGatewayTest(limitedTo)
+		w := g.serve(url.Values{"fields": {"sku,provider.id"}})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		md := GatewayMetadata(context.Background(), g.request)
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		interceptor := NewInterceptor(limitedTo)
+		Register[*pb.Product](interceptor, getProductMethod, generated.NewProductFieldMask)
+
+		resp, err := interceptor.Unary()(ctx, &getProductRequest{Sku: "SKU01"},
+			&grpc.UnaryServerInfo{FullMethod: getProductMethod},
+			func(ctx context.Context, req any) (any, error) {
+				return newProduct("SKU01"), nil
+			},
+		)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fieldmask: field not found or not allowed 'provider'",
+			status.Convert(err).Message(),
+		)
+	})
+}
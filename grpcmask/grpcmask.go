@@ -0,0 +1,344 @@
+// Package grpcmask provides gRPC server interceptors masking the responses with the generated field masks,
+// using the fields read from the requests or from the incoming metadata.
+// The metadata can be set by grpc-gateway from a query parameter, using GatewayHandler and GatewayMetadata.
+package grpcmask
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+// DefaultMetadataKey is the default metadata key of the masked fields, e.g. 'sku,provider.{id|name}'
+const DefaultMetadataKey = "x-field-mask"
+
+const fieldMaskFullName = "google.protobuf.FieldMask"
+
+// FieldMask is the method of the generated XxxFieldMask used by the interceptors
+type FieldMask[T any] interface {
+	Mask(msg T) T
+}
+
+// maskFunc returns the masked response, responses of other types are returned unchanged
+type maskFunc func(resp any) any
+
+type newMaskFunc func(maskedFields []string, options []fields.Option) (maskFunc, error)
+
+type maskOptions struct {
+	requestFields []protoreflect.Name
+	queryParam    string
+	metadataKey   string
+	fieldOptions  []fields.Option
+}
+
+func computeOptions(options []Option) maskOptions {
+	opts := maskOptions{
+		queryParam:   DefaultQueryParam,
+		metadataKey:  DefaultMetadataKey,
+		fieldOptions: []fields.Option{fields.WithNameStyle(fields.NameStyleBoth)},
+	}
+	for _, fn := range options {
+		fn(&opts)
+	}
+	opts.requestFields = append(opts.requestFields, "read_mask", "field_mask")
+	return opts
+}
+
+// Option ...
+type Option func(opts *maskOptions)
+
+// WithRequestField adds a request field to read the masked fields from, checked before the default fields
+// 'read_mask' and 'field_mask'. The field can be a google.protobuf.FieldMask, a repeated string,
+// or a string with the fields separated by commas.
+// The paths of a google.protobuf.FieldMask are plain dot paths merged as in fields.FromFieldMask,
+// the bracket syntax is only used for the string fields
+func WithRequestField(name string) Option {
+	return func(opts *maskOptions) {
+		opts.requestFields = append(opts.requestFields, protoreflect.Name(name))
+	}
+}
+
+// WithMetadataKey sets the metadata key of the masked fields, used when the request does not have any of them,
+// and the metadata key set by GatewayMetadata.
+// An empty key disables reading the masked fields from metadata
+func WithMetadataKey(key string) Option {
+	return func(opts *maskOptions) {
+		opts.metadataKey = key
+	}
+}
+
+// WithFieldOptions sets the options for creating the field masks.
+// Both proto names and JSON names are accepted by default, the same as NewXxxFieldMaskFromProto
+func WithFieldOptions(options ...fields.Option) Option {
+	return func(opts *maskOptions) {
+		opts.fieldOptions = append(opts.fieldOptions, options...)
+	}
+}
+
+// Interceptor masks the responses of the registered methods.
+// Responses of methods that are not registered, or of requests without any masked fields, are not modified
+type Interceptor struct {
+	opts    maskOptions
+	methods map[string]newMaskFunc
+}
+
+// NewInterceptor creates an interceptor without any registered methods
+func NewInterceptor(options ...Option) *Interceptor {
+	return &Interceptor{
+		opts:    computeOptions(options),
+		methods: map[string]newMaskFunc{},
+	}
+}
+
+// Register sets the constructor of the generated field mask for the response type T of the method,
+// e.g. Register[*pb.Product](interceptor, "/shop.ProductService/GetProduct", NewProductFieldMask).
+// Must be called before serving requests
+func Register[T any, M FieldMask[T]](
+	i *Interceptor, fullMethod string,
+	newFieldMask func(maskedFields []string, options ...fields.Option) (M, error),
+) {
+	i.methods[fullMethod] = func(maskedFields []string, options []fields.Option) (maskFunc, error) {
+		fm, err := newFieldMask(maskedFields, options...)
+		if err != nil {
+			return nil, err
+		}
+		return func(resp any) any {
+			msg, ok := resp.(T)
+			if !ok {
+				return resp
+			}
+			return fm.Mask(msg)
+		}, nil
+	}
+}
+
+// Unary returns the interceptor of unary methods.
+// The field mask is validated before calling the handler, returning codes.InvalidArgument on errors
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		newMask, ok := i.methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		maskedFields, found, err := i.fieldsFromRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			maskedFields, found = i.fieldsFromMetadata(ctx)
+		}
+		if !found {
+			return handler(ctx, req)
+		}
+
+		mask, err := i.newMask(newMask, maskedFields)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		return mask(resp), nil
+	}
+}
+
+// Stream returns the interceptor of streaming methods.
+// The field mask from metadata is validated before calling the handler,
+// the field masks from the received requests are validated when receiving them and replace the previous one
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		newMask, ok := i.methods[info.FullMethod]
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		stream := &maskedServerStream{
+			ServerStream: ss,
+			interceptor:  i,
+			newMask:      newMask,
+		}
+
+		maskedFields, found := i.fieldsFromMetadata(ss.Context())
+		if found {
+			mask, err := i.newMask(newMask, maskedFields)
+			if err != nil {
+				return err
+			}
+			stream.mask = mask
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func (i *Interceptor) newMask(newMask newMaskFunc, maskedFields []string) (maskFunc, error) {
+	mask, err := newMask(maskedFields, i.opts.fieldOptions)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return mask, nil
+}
+
+func (i *Interceptor) fieldsFromMetadata(ctx context.Context) ([]string, bool) {
+	if i.opts.metadataKey == "" {
+		return nil, false
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, i.opts.metadataKey)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	var result []string
+	for _, value := range values {
+		result = append(result, splitFields(value)...)
+	}
+	return result, true
+}
+
+func (i *Interceptor) fieldsFromRequest(req any) ([]string, bool, error) {
+	msg := getProtoReflect(req)
+	if msg == nil {
+		return nil, false, nil
+	}
+
+	for _, name := range i.opts.requestFields {
+		desc := msg.Descriptor().Fields().ByName(name)
+		if desc == nil || !msg.Has(desc) {
+			continue
+		}
+		maskedFields, ok, err := getFieldValues(msg, desc, i.opts.fieldOptions)
+		if err != nil {
+			return nil, false, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if ok {
+			return maskedFields, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func getProtoReflect(req any) protoreflect.Message {
+	switch msg := req.(type) {
+	case protoreflect.ProtoMessage:
+		return msg.ProtoReflect()
+	case protov1.Message:
+		return protov1.MessageReflect(msg)
+	default:
+		return nil
+	}
+}
+
+// getFieldValues returns the masked fields of a google.protobuf.FieldMask, a repeated string or a string field
+func getFieldValues(
+	msg protoreflect.Message, desc protoreflect.FieldDescriptor, options []fields.Option,
+) ([]string, bool, error) {
+	switch {
+	case desc.IsList() && desc.Kind() == protoreflect.StringKind:
+		return getStringList(msg.Get(desc).List()), true, nil
+
+	case desc.Kind() == protoreflect.StringKind && desc.Cardinality() != protoreflect.Repeated:
+		return splitFields(msg.Get(desc).String()), true, nil
+
+	case desc.Message() != nil && desc.Message().FullName() == fieldMaskFullName && !desc.IsList():
+		return getFieldMaskValues(msg.Get(desc).Message(), options)
+
+	default:
+		return nil, false, nil
+	}
+}
+
+// getFieldMaskValues parses the paths of a google.protobuf.FieldMask as plain dot paths,
+// and formats them to the masked fields without the overlapping paths, e.g. 'provider' and 'provider.id'
+func getFieldMaskValues(fieldMask protoreflect.Message, options []fields.Option) ([]string, bool, error) {
+	paths := fieldMask.Descriptor().Fields().ByName("paths")
+	fieldInfos, err := fields.FromFieldMask(&fieldmaskpb.FieldMask{
+		Paths: getStringList(fieldMask.Get(paths).List()),
+	}, options...)
+	if err != nil {
+		return nil, false, err
+	}
+	return fields.Format(fieldInfos), true, nil
+}
+
+func getStringList(list protoreflect.List) []string {
+	result := make([]string, 0, list.Len())
+	for index := 0; index < list.Len(); index++ {
+		result = append(result, list.Get(index).String())
+	}
+	return result
+}
+
+// splitFields splits the fields separated by commas, commas are not used inside the bracket syntax
+func splitFields(value string) []string {
+	var result []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+type maskedServerStream struct {
+	grpc.ServerStream
+
+	interceptor *Interceptor
+	newMask     newMaskFunc
+
+	mut  sync.Mutex
+	mask maskFunc
+}
+
+func (s *maskedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	maskedFields, found, err := s.interceptor.fieldsFromRequest(m)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	mask, err := s.interceptor.newMask(s.newMask, maskedFields)
+	if err != nil {
+		return err
+	}
+
+	s.mut.Lock()
+	s.mask = mask
+	s.mut.Unlock()
+	return nil
+}
+
+func (s *maskedServerStream) SendMsg(m any) error {
+	s.mut.Lock()
+	mask := s.mask
+	s.mut.Unlock()
+
+	if mask != nil {
+		m = mask(m)
+	}
+	return s.ServerStream.SendMsg(m)
+}
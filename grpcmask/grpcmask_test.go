@@ -0,0 +1,447 @@
+package grpcmask
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/generated"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+type getProductRequest struct {
+	Sku      string                 `protobuf:"bytes,1,opt,name=sku,proto3"`
+	Fields   []string               `protobuf:"bytes,2,rep,name=fields,proto3"`
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3"`
+	Filter   string                 `protobuf:"bytes,4,opt,name=filter,proto3"`
+}
+
+func (m *getProductRequest) Reset()         { *m = getProductRequest{} }
+func (m *getProductRequest) String() string { return protov1.CompactTextString(m) }
+func (*getProductRequest) ProtoMessage()    {}
+
+const (
+	getProductMethod    = "/test.ProductService/GetProduct"
+	listProductsMethod  = "/test.ProductService/ListProducts"
+	watchProductsMethod = "/test.ProductService/WatchProducts"
+)
+
+func newProduct(sku string) *pb.Product {
+	return &pb.Product{
+		Sku:        sku,
+		BrandCodes: []string{"BRAND01"},
+		Provider: &pb.ProviderInfo{
+			Id:   21,
+			Name: "Provider Name",
+			Logo: "logo.png",
+		},
+	}
+}
+
+type productService struct {
+	getCalls int
+}
+
+func (s *productService) getProduct(_ context.Context, req *getProductRequest) (*pb.Product, error) {
+	s.getCalls++
+	return newProduct(req.Sku), nil
+}
+
+func (*productService) listProducts(req *getProductRequest, stream grpc.ServerStream) error {
+	for _, sku := range []string{req.Sku + "01", req.Sku + "02"} {
+		if err := stream.SendMsg(newProduct(sku)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var productServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.ProductService",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProduct",
+			Handler: func(
+				srv any, ctx context.Context, dec func(any) error,
+				interceptor grpc.UnaryServerInterceptor,
+			) (any, error) {
+				req := &getProductRequest{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: getProductMethod}
+				return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+					return srv.(*productService).getProduct(ctx, req.(*getProductRequest))
+				})
+			},
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListProducts",
+			ServerStreams: true,
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				req := &getProductRequest{}
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+				return srv.(*productService).listProducts(req, stream)
+			},
+		},
+		{
+			StreamName:    "WatchProducts",
+			ServerStreams: true,
+			ClientStreams: true,
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				for {
+					req := &getProductRequest{}
+					err := stream.RecvMsg(req)
+					if err == io.EOF {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					if err := stream.SendMsg(newProduct(req.Sku)); err != nil {
+						return err
+					}
+				}
+			},
+		},
+	},
+}
+
+type interceptorTest struct {
+	service *productService
+	conn    *grpc.ClientConn
+}
+
+func newInterceptorTest(t *testing.T, options ...Option) *interceptorTest {
+	interceptor := NewInterceptor(options...)
+	Register[*pb.Product](interceptor, getProductMethod, generated.NewProductFieldMask)
+	Register[*pb.Product](interceptor, listProductsMethod, generated.NewProductFieldMask)
+	Register[*pb.Product](interceptor, watchProductsMethod, generated.NewProductFieldMask)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(interceptor.Unary()),
+		grpc.StreamInterceptor(interceptor.Stream()),
+	)
+	service := &productService{}
+	server.RegisterService(&productServiceDesc, service)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		panic(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &interceptorTest{
+		service: service,
+		conn:    conn,
+	}
+}
+
+func (it *interceptorTest) getProduct(ctx context.Context, req *getProductRequest) (*pb.Product, error) {
+	resp := &pb.Product{}
+	err := it.conn.Invoke(ctx, getProductMethod, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (it *interceptorTest) listProducts(ctx context.Context, req *getProductRequest) ([]*pb.Product, error) {
+	stream, err := it.conn.NewStream(ctx, &productServiceDesc.Streams[0], listProductsMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return recvProducts(stream)
+}
+
+func recvProducts(stream grpc.ClientStream) ([]*pb.Product, error) {
+	var result []*pb.Product
+	for {
+		resp := &pb.Product{}
+		err := stream.RecvMsg(resp)
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resp)
+	}
+}
+
+func TestInterceptor_Unary(t *testing.T) {
+	t.Run("read mask", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku", "provider.image_url", "provider.name"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: &pb.ProviderInfo{Name: "Provider Name"},
+		}, resp)
+	})
+
+	t.Run("read mask with overlapping paths", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"provider", "provider.id", "sku", "sku"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU01",
+			Provider: &pb.ProviderInfo{
+				Id:   21,
+				Name: "Provider Name",
+				Logo: "logo.png",
+			},
+		}, resp)
+	})
+
+	t.Run("read mask with bracket syntax", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"provider.{id|name}"}},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fields: invalid field mask path 'provider.{id|name}'",
+			status.Convert(err).Message(),
+		)
+		assert.Equal(t, 0, it.service.getCalls)
+	})
+
+	t.Run("configured request field", func(t *testing.T) {
+		it := newInterceptorTest(t, WithRequestField("fields"))
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			Fields:   []string{"brandCodes", "provider.{id|logo}"},
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Provider:   &pb.ProviderInfo{Id: 21, Logo: "logo.png"},
+			BrandCodes: []string{"BRAND01"},
+		}, resp)
+	})
+
+	t.Run("string request field", func(t *testing.T) {
+		it := newInterceptorTest(t, WithRequestField("filter"))
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:    "SKU01",
+			Filter: "sku, provider.{id|logo}",
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: &pb.ProviderInfo{Id: 21, Logo: "logo.png"},
+		}, resp)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), DefaultMetadataKey, "sku,provider.id")
+		resp, err := it.getProduct(ctx, &getProductRequest{Sku: "SKU01"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: &pb.ProviderInfo{Id: 21},
+		}, resp)
+	})
+
+	t.Run("metadata disabled", func(t *testing.T) {
+		it := newInterceptorTest(t, WithMetadataKey(""))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), DefaultMetadataKey, "sku")
+		resp, err := it.getProduct(ctx, &getProductRequest{Sku: "SKU01"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, newProduct("SKU01"), resp)
+	})
+
+	t.Run("without mask", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{Sku: "SKU01"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, newProduct("SKU01"), resp)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku", "provider.unknown"}},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fieldmask: field not found or not allowed 'provider.unknown'",
+			status.Convert(err).Message(),
+		)
+		assert.Equal(t, 0, it.service.getCalls)
+	})
+
+	t.Run("restricted field", func(t *testing.T) {
+		it := newInterceptorTest(t, WithFieldOptions(
+			fields.WithLimitedToFields([]string{"sku", "provider.{id|imageUrl}"}),
+		))
+
+		resp, err := it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku", "provider.image_url", "provider.name"}},
+		})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fieldmask: field not found or not allowed 'provider.name'",
+			status.Convert(err).Message(),
+		)
+		assert.Equal(t, 0, it.service.getCalls)
+
+		resp, err = it.getProduct(context.Background(), &getProductRequest{
+			Sku:      "SKU01",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku", "provider.image_url"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{Sku: "SKU01", Provider: &pb.ProviderInfo{}}, resp)
+	})
+}
+
+func TestInterceptor_Stream(t *testing.T) {
+	t.Run("server streaming", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		products, err := it.listProducts(context.Background(), &getProductRequest{
+			Sku:      "SKU",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []*pb.Product{{Sku: "SKU01"}, {Sku: "SKU02"}}, products)
+	})
+
+	t.Run("server streaming with overlapping paths", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		products, err := it.listProducts(context.Background(), &getProductRequest{
+			Sku:      "SKU",
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"provider.name", "provider"}},
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []*pb.Product{
+			{Provider: newProduct("SKU01").Provider},
+			{Provider: newProduct("SKU02").Provider},
+		}, products)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), DefaultMetadataKey, "brand_codes")
+		products, err := it.listProducts(ctx, &getProductRequest{Sku: "SKU"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []*pb.Product{
+			{BrandCodes: []string{"BRAND01"}},
+			{BrandCodes: []string{"BRAND01"}},
+		}, products)
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), DefaultMetadataKey, "provider.{id|unknown}")
+		products, err := it.listProducts(ctx, &getProductRequest{Sku: "SKU"})
+		assert.Nil(t, products)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fieldmask: field not found or not allowed 'provider.unknown'",
+			status.Convert(err).Message(),
+		)
+	})
+
+	t.Run("restricted field in metadata", func(t *testing.T) {
+		it := newInterceptorTest(t, WithFieldOptions(fields.WithLimitedToFields([]string{"sku"})))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), DefaultMetadataKey, "sku,brand_codes")
+		products, err := it.listProducts(ctx, &getProductRequest{Sku: "SKU"})
+		assert.Nil(t, products)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t,
+			"fieldmask: field not found or not allowed 'brandCodes'",
+			status.Convert(err).Message(),
+		)
+	})
+
+	t.Run("mask of each request", func(t *testing.T) {
+		it := newInterceptorTest(t)
+
+		stream, err := it.conn.NewStream(context.Background(), &productServiceDesc.Streams[1], watchProductsMethod)
+		assert.Equal(t, nil, err)
+
+		requests := []*getProductRequest{
+			{Sku: "SKU01", ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"sku"}}},
+			{Sku: "SKU02"},
+			{Sku: "SKU03", ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"provider.name"}}},
+		}
+		for _, req := range requests {
+			assert.Equal(t, nil, stream.SendMsg(req))
+		}
+		assert.Equal(t, nil, stream.CloseSend())
+
+		products, err := recvProducts(stream)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []*pb.Product{
+			{Sku: "SKU01"},
+			{Sku: "SKU02"},
+			{Provider: &pb.ProviderInfo{Name: "Provider Name"}},
+		}, products)
+	})
+}
+
+func TestSplitFields(t *testing.T) {
+	assert.Equal(t, []string{"sku", "provider.{id|name}"}, splitFields(" sku, provider.{id|name},"))
+	assert.Nil(t, splitFields(""))
+}
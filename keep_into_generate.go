@@ -0,0 +1,244 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+type keepIntoFunc struct {
+	FuncName       string
+	MaskTypeName   string
+	QualifiedType  string
+	AllMaskVarName string
+	AllMaskBits    []string
+
+	CaptureStmts []string
+	FieldStmts   []string
+}
+
+func getKeepIntoFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_KeepInto", e.alias, e.typeName)
+}
+
+func getAllMaskVarName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_AllMask", e.alias, e.typeName)
+}
+
+// getAllMaskBits returns the words of the bits selecting all fields, with nil sub masks
+func getAllMaskBits(fieldCount int) []string {
+	var result []string
+	for start := 0; start < fieldCount; start += 64 {
+		count := fieldCount - start
+		if count >= 64 {
+			result = append(result, "0xffffffffffffffff")
+			continue
+		}
+		result = append(result, fmt.Sprintf("0x%x", uint64(1)<<count-1))
+	}
+	return result
+}
+
+// getStructFieldName returns the name of the struct field containing the field,
+// which is the interface field for oneof members
+func getStructFieldName(field objectField) string {
+	if field.oneof != nil {
+		return field.oneof.fieldName
+	}
+	return field.name
+}
+
+// buildKeepIntoCaptureStmts reads all fields of src, and the reusable sub messages of dst,
+// before dst is reset, so that dst and src can be the same message
+func buildKeepIntoCaptureStmts(info *objectInfo) []string {
+	var names []string
+	hasObject := map[string]bool{}
+
+	for _, field := range info.subFields {
+		name := getStructFieldName(field)
+		if _, existed := hasObject[name]; !existed {
+			names = append(names, name)
+		}
+		hasObject[name] = hasObject[name] || field.info != nil
+	}
+
+	var result []string
+	for _, name := range names {
+		result = append(result, fmt.Sprintf("src%s := src.%s", name, name))
+		if hasObject[name] {
+			result = append(result, fmt.Sprintf("old%s := dst.%s", name, name))
+		}
+	}
+	return result
+}
+
+func keepIntoStmtForObject(field objectField) string {
+	result := fmt.Sprintf(`
+if src%s != nil {
+	subMsg := old%s
+	if subMsg == nil {
+		subMsg = &%s{}
+	}
+	%s(m.%s, subMsg, src%s)
+	dst.%s = subMsg
+}
+`,
+		field.name,
+		field.name,
+		getQualifiedTypeName(field.info),
+		getKeepIntoFuncName(field.info), field.name, field.name,
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func keepIntoStmtForArrayOfObjects(field objectField) string {
+	result := fmt.Sprintf(`
+msgList := old%s[:0]
+for i, e := range src%s {
+	if e == nil {
+		msgList = append(msgList, nil)
+		continue
+	}
+	var subMsg *%s
+	if i < cap(old%s) {
+		subMsg = old%s[:cap(old%s)][i]
+	}
+	if subMsg == nil {
+		subMsg = &%s{}
+	}
+	%s(m.%s, subMsg, e)
+	msgList = append(msgList, subMsg)
+}
+dst.%s = msgList
+`,
+		field.name,
+		field.name,
+		getQualifiedTypeName(field.info),
+		field.name,
+		field.name, field.name,
+		getQualifiedTypeName(field.info),
+		getKeepIntoFuncName(field.info), field.name,
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func keepIntoStmtForMapOfObjects(field objectField) string {
+	result := fmt.Sprintf(`
+msgMap := old%s
+if msgMap == nil {
+	msgMap = make(map[%s]*%s, len(src%s))
+}
+for k := range msgMap {
+	if _, ok := src%s[k]; !ok {
+		delete(msgMap, k)
+	}
+}
+for k, e := range src%s {
+	if e == nil {
+		msgMap[k] = nil
+		continue
+	}
+	subMsg := msgMap[k]
+	if subMsg == nil {
+		subMsg = &%s{}
+	}
+	%s(m.%s, subMsg, e)
+	msgMap[k] = subMsg
+}
+dst.%s = msgMap
+`,
+		field.name,
+		field.mapKeyType, getQualifiedTypeName(field.info), field.name,
+		field.name,
+		field.name,
+		getQualifiedTypeName(field.info),
+		getKeepIntoFuncName(field.info), field.name,
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func keepIntoStmtForOneofObject(obj *objectInfo, field objectField) string {
+	wrapperType := getOneofWrapperTypeName(obj, field.oneof)
+	oneofName := field.oneof.fieldName
+
+	result := fmt.Sprintf(`
+if wrapper, ok := src%s.(*%s); ok && wrapper.%s != nil {
+	dstWrapper, ok := old%s.(*%s)
+	if !ok || dstWrapper.%s == nil {
+		dstWrapper = &%s{%s: &%s{}}
+	}
+	%s(m.%s, dstWrapper.%s, wrapper.%s)
+	dst.%s = dstWrapper
+}
+`,
+		oneofName, wrapperType, field.name,
+		oneofName, wrapperType,
+		field.name,
+		wrapperType, field.name, getQualifiedTypeName(field.info),
+		getKeepIntoFuncName(field.info), field.name, field.name, field.name,
+		oneofName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func keepIntoStmtForOneof(obj *objectInfo, field objectField) string {
+	result := fmt.Sprintf(`
+if wrapper, ok := src%s.(*%s); ok {
+	dst.%s = wrapper
+}
+`,
+		field.oneof.fieldName, getOneofWrapperTypeName(obj, field.oneof),
+		field.oneof.fieldName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildKeepIntoStmtForField(info *objectInfo, index int, subField objectField) string {
+	var stmt string
+	switch {
+	case subField.fieldType == fieldTypeObject && subField.oneof != nil:
+		stmt = keepIntoStmtForOneofObject(info, subField)
+
+	case subField.fieldType == fieldTypeObject:
+		stmt = keepIntoStmtForObject(subField)
+
+	case subField.fieldType == fieldTypeArrayOfObjects:
+		stmt = keepIntoStmtForArrayOfObjects(subField)
+
+	case subField.fieldType == fieldTypeMapOfObjects:
+		stmt = keepIntoStmtForMapOfObjects(subField)
+
+	case subField.fieldType == fieldTypeStruct:
+		stmt = fmt.Sprintf("dst.%s = %s(m.%s, src%s)",
+			subField.name, getKeepKeysFuncName(subField.structInfo), subField.name, subField.name,
+		)
+
+	case subField.oneof != nil:
+		stmt = keepIntoStmtForOneof(info, subField)
+
+	default:
+		stmt = fmt.Sprintf("dst.%s = src%s", subField.name, subField.name)
+	}
+
+	return fmt.Sprintf("if %s {\n%s\n}", getBitExpr(index), stmt)
+}
+
+func buildKeepIntoFunc(info *objectInfo) keepIntoFunc {
+	fieldStmts := make([]string, 0, len(info.subFields))
+	for index, subField := range info.subFields {
+		fieldStmts = append(fieldStmts, buildKeepIntoStmtForField(info, index, subField))
+	}
+
+	return keepIntoFunc{
+		FuncName:       getKeepIntoFuncName(info),
+		MaskTypeName:   getMaskTypeName(info),
+		QualifiedType:  getQualifiedTypeName(info),
+		AllMaskVarName: getAllMaskVarName(info),
+		AllMaskBits:    getAllMaskBits(len(info.subFields)),
+
+		CaptureStmts: buildKeepIntoCaptureStmts(info),
+		FieldStmts:   fieldStmts,
+	}
+}
@@ -0,0 +1,94 @@
+package fieldmap
+
+import (
+	"fmt"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+// ColumnProjection is the result of ProjectColumns
+type ColumnProjection struct {
+	// Columns are the full column tags of the selected fields, e.g. 'sku' or 'seller.name',
+	// in the order of struct fields
+	Columns []string
+
+	// Joins are the full column tags of the nested structs having selected fields, e.g. 'seller',
+	// in the order of struct fields, parents before their nested structs
+	Joins []string
+}
+
+func (f *FieldMap[F, T]) checkStructTag(tag string) {
+	if _, ok := f.structTags[tag]; !ok {
+		panic(fmt.Sprintf("struct tag %q is not declared by WithStructTags", tag))
+	}
+}
+
+func (f *FieldMap[F, T]) markLeafFields(selected []bool, field F) {
+	if !f.IsStruct(field) {
+		selected[f.indexOf(field)] = true
+		return
+	}
+	for _, child := range f.ChildrenOf(field) {
+		f.markLeafFields(selected, child)
+	}
+}
+
+// computeJoined returns the root struct and the structs that are the ancestors of the selected leaf fields
+func (f *FieldMap[F, T]) computeJoined(selected []bool) []bool {
+	joined := make([]bool, len(f.fields))
+	joined[f.indexOf(f.structRoot)] = true
+	for index, ok := range selected {
+		if !ok {
+			continue
+		}
+		for _, ancestor := range f.AncestorOf(f.ParentOf(f.fields[index])) {
+			joined[f.indexOf(ancestor)] = true
+		}
+	}
+	return joined
+}
+
+// ProjectColumns computes the columns for a SELECT from the masked fields associated with fieldTag,
+// using the values of columnTag as the column names. An empty list of masked fields means all fields.
+//
+// requiredFields (e.g. primary keys) are always selected when their parent struct has any selected fields,
+// fields of the root struct are always selected
+func (f *FieldMap[F, T]) ProjectColumns(
+	fieldTag string, columnTag string,
+	maskedFields []fields.FieldInfo, requiredFields ...F,
+) (ColumnProjection, error) {
+	f.checkStructTag(columnTag)
+
+	selectedFields := []F{f.structRoot}
+	if len(maskedFields) > 0 {
+		var err error
+		selectedFields, err = f.FromMaskedFields(fieldTag, maskedFields)
+		if err != nil {
+			return ColumnProjection{}, err
+		}
+	}
+
+	selected := make([]bool, len(f.fields))
+	for _, field := range selectedFields {
+		f.markLeafFields(selected, field)
+	}
+
+	joined := f.computeJoined(selected)
+	for _, field := range requiredFields {
+		if joined[f.indexOf(f.ParentOf(field))] {
+			f.markLeafFields(selected, field)
+		}
+	}
+	joined = f.computeJoined(selected)
+
+	var result ColumnProjection
+	for index, field := range f.fields {
+		if selected[index] {
+			result.Columns = append(result.Columns, f.GetFullStructTag(columnTag, field))
+		}
+		if joined[index] && field != f.structRoot {
+			result.Joins = append(result.Joins, f.GetFullStructTag(columnTag, field))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package fieldmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/QuangTung97/fieldmask/fields"
+)
+
+type sellerAttrColumns struct {
+	Root field
+
+	ID   field `json:"id" db:"id"`
+	Code field `json:"code" db:"code"`
+}
+
+type sellerColumns struct {
+	Root field
+
+	ID   field             `json:"id" db:"id"`
+	Name field             `json:"name" db:"name"`
+	Attr sellerAttrColumns `json:"attr" db:"seller_attrs"`
+}
+
+type productColumns struct {
+	Root     field
+	ID       field         `json:"id" db:"id"`
+	Sku      field         `json:"sku" db:"sku"`
+	Seller   sellerColumns `json:"seller" db:"sellers"`
+	ImageURL field         `json:"imageUrl" db:"image_url"`
+}
+
+func (d productColumns) GetRoot() field {
+	return d.Root
+}
+
+func TestFieldMap__ProjectColumns(t *testing.T) {
+	fm := New[field, productColumns](WithStructTags("json", "db"))
+	p := fm.GetMapping()
+
+	project := func(maskedFields []string, requiredFields ...field) (ColumnProjection, error) {
+		infos, err := fields.ComputeFieldInfos(maskedFields)
+		if err != nil {
+			panic(err)
+		}
+		return fm.ProjectColumns("json", "db", infos, requiredFields...)
+	}
+
+	t.Run("simple", func(t *testing.T) {
+		result, err := project([]string{"imageUrl", "sku"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{"sku", "image_url"},
+		}, result)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		result, err := project([]string{"sku", "seller.{name|attr.code}"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{"sku", "sellers.name", "sellers.seller_attrs.code"},
+			Joins:   []string{"sellers", "sellers.seller_attrs"},
+		}, result)
+	})
+
+	t.Run("whole nested struct", func(t *testing.T) {
+		result, err := project([]string{"seller"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{
+				"sellers.id", "sellers.name",
+				"sellers.seller_attrs.id", "sellers.seller_attrs.code",
+			},
+			Joins: []string{"sellers", "sellers.seller_attrs"},
+		}, result)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		result, err := project([]string{"seller.name"}, p.ID, p.Seller.ID, p.Seller.Attr.ID)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{"id", "sellers.id", "sellers.name"},
+			Joins:   []string{"sellers"},
+		}, result)
+
+		result, err = project([]string{"sku"}, p.ID, p.Seller.ID)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{"id", "sku"},
+		}, result)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		result, err := fm.ProjectColumns("json", "db", nil, p.ID)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, ColumnProjection{
+			Columns: []string{
+				"id", "sku",
+				"sellers.id", "sellers.name",
+				"sellers.seller_attrs.id", "sellers.seller_attrs.code",
+				"image_url",
+			},
+			Joins: []string{"sellers", "sellers.seller_attrs"},
+		}, result)
+
+		wildcard, err := project([]string{"*"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, result, wildcard)
+	})
+
+	t.Run("field not found", func(t *testing.T) {
+		result, err := project([]string{"sku", "seller.logo"})
+		assert.Equal(t, fields.ErrFieldNotFound("seller.logo"), err)
+		assert.Equal(t, ColumnProjection{}, result)
+	})
+
+	t.Run("missing column tag", func(t *testing.T) {
+		assert.PanicsWithValue(t, `struct tag "sql" is not declared by WithStructTags`, func() {
+			_, _ = fm.ProjectColumns("json", "sql", nil)
+		})
+	})
+}
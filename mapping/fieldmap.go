@@ -39,8 +39,9 @@ type FieldMap[F Field, T MapType[F]] struct {
 }
 
 type tagToFieldMapping[F Field] struct {
-	field     F // empty for the first
-	subFields map[string]*tagToFieldMapping[F]
+	field         F // empty for the first
+	subFields     map[string]*tagToFieldMapping[F]
+	subFieldNames []string // in the order of struct fields
 }
 
 func (i *tagToFieldMapping[F]) getSubFields() map[string]*tagToFieldMapping[F] {
@@ -364,6 +365,7 @@ func (f *FieldMap[F, T]) buildTagMappingForField(tag string, field F) *tagToFiel
 	for _, childField := range childrenFields {
 		tagValue := f.GetStructTag(tag, childField)
 		tagMapping.getSubFields()[tagValue] = f.buildTagMappingForField(tag, childField)
+		tagMapping.subFieldNames = append(tagMapping.subFieldNames, tagValue)
 	}
 
 	return tagMapping
@@ -382,10 +384,23 @@ func (f *FieldMap[F, T]) getTagMapping(tag string) *tagToFieldMapping[F] {
 	return f.tagsIndex[tag]
 }
 
+// directFieldInfos returns all direct fields of the tag mapping, for expanding the wildcard
+func (*FieldMap[F, T]) directFieldInfos(tagMapping *tagToFieldMapping[F]) []fields.FieldInfo {
+	result := make([]fields.FieldInfo, 0, len(tagMapping.subFieldNames))
+	for _, name := range tagMapping.subFieldNames {
+		result = append(result, fields.FieldInfo{FieldName: name})
+	}
+	return result
+}
+
 func (f *FieldMap[F, T]) fromMaskedFieldsRecursive(
 	tagMapping *tagToFieldMapping[F],
 	maskedFields []fields.FieldInfo, result []F,
 ) ([]F, error) {
+	if fields.HasWildcard(maskedFields) {
+		maskedFields = fields.ExpandWildcard(maskedFields, f.directFieldInfos(tagMapping))
+	}
+
 	for _, maskedField := range maskedFields {
 		subTagMapping, ok := tagMapping.subFields[maskedField.FieldName]
 		if !ok {
@@ -481,4 +481,64 @@ func TestFieldMap__FromMaskedFields(t *testing.T) {
 			mapping.Product.Name,
 		}, result)
 	})
+	t.Run("wildcard at top level", func(t *testing.T) {
+		fm := New[field, productData](WithStructTags("json"))
+
+		mapping := fm.GetMapping()
+
+		result, err := fm.FromMaskedFields("json", []fields.FieldInfo{
+			{FieldName: fields.Wildcard},
+		})
+
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []field{
+			mapping.Sku,
+			mapping.Name,
+			mapping.Seller.Root,
+			mapping.ImageURL,
+		}, result)
+	})
+
+	t.Run("wildcard of sub struct", func(t *testing.T) {
+		fm := New[field, productData](WithStructTags("json"))
+
+		mapping := fm.GetMapping()
+
+		result, err := fm.FromMaskedFields("json", []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "seller",
+				SubFields: []fields.FieldInfo{
+					{FieldName: "attr", SubFields: []fields.FieldInfo{{FieldName: "code"}}},
+					{FieldName: fields.Wildcard},
+				},
+			},
+		})
+
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []field{
+			mapping.Sku,
+			mapping.Seller.ID,
+			mapping.Seller.Name,
+			mapping.Seller.Logo,
+			mapping.Seller.Attr.Root,
+		}, result)
+	})
+
+	t.Run("wildcard with not found field", func(t *testing.T) {
+		fm := New[field, productData](WithStructTags("json"))
+
+		result, err := fm.FromMaskedFields("json", []fields.FieldInfo{
+			{
+				FieldName: "seller",
+				SubFields: []fields.FieldInfo{
+					{FieldName: fields.Wildcard},
+					{FieldName: "xxyy"},
+				},
+			},
+		})
+
+		assert.Equal(t, fields.ErrFieldNotFound("seller.xxyy"), err)
+		assert.Nil(t, result)
+	})
 }
@@ -0,0 +1,105 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+type normalizeFunc struct {
+	FuncName   string
+	FieldStmts []string
+}
+
+// getCaseNames returns the list of accepted names of the field for the case clauses of the generated switch
+func getCaseNames(field objectField) string {
+	if field.protoName == "" || field.protoName == field.jsonName {
+		return fmt.Sprintf("%q", field.jsonName)
+	}
+	return fmt.Sprintf("%q, %q", field.jsonName, field.protoName)
+}
+
+func getNormalizeFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_NormalizeFieldNames", e.alias, e.typeName)
+}
+
+func getProtoName(field objectField) string {
+	if field.protoName == "" {
+		return field.jsonName
+	}
+	return field.protoName
+}
+
+func normalizeStmtForField(field objectField) string {
+	if field.info == nil {
+		result := fmt.Sprintf(`
+case style.Match(field.FieldName, %q, %q):
+	field.FieldName = %q
+`,
+			field.jsonName, getProtoName(field),
+			field.jsonName,
+		)
+		return strings.TrimSpace(result)
+	}
+
+	result := fmt.Sprintf(`
+case style.Match(field.FieldName, %q, %q):
+	subFields, err := %s(field.SubFields, style)
+	if err != nil {
+		return nil, fields.PrependParentField(err, field.FieldName)
+	}
+	field.FieldName = %q
+	field.SubFields = subFields
+`,
+		field.jsonName, getProtoName(field),
+		getNormalizeFuncName(field.info),
+		field.jsonName,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildNormalizeFunc(info *objectInfo) normalizeFunc {
+	return normalizeFunc{
+		FuncName:   getNormalizeFuncName(info),
+		FieldStmts: mapSlice(info.subFields, normalizeStmtForField),
+	}
+}
+
+type toProtoNamesFunc struct {
+	FuncName         string
+	AllFieldsVarName string
+	FieldStmts       []string
+}
+
+func getToProtoNamesFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_ToProtoNames", e.alias, e.typeName)
+}
+
+// toProtoNamesStmtForField returns the case clause renaming the field to the proto name,
+// empty if neither the field nor its sub fields need to be renamed
+func toProtoNamesStmtForField(field objectField) string {
+	var stmts []string
+	if getProtoName(field) != field.jsonName {
+		stmts = append(stmts, fmt.Sprintf("field.FieldName = %q", getProtoName(field)))
+	}
+	if field.info != nil {
+		stmts = append(stmts, fmt.Sprintf("field.SubFields = %s(field.SubFields)", getToProtoNamesFuncName(field.info)))
+	}
+	if len(stmts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("case %q:\n%s", field.jsonName, strings.Join(stmts, "\n"))
+}
+
+func buildToProtoNamesFunc(info *objectInfo) toProtoNamesFunc {
+	var stmts []string
+	for _, field := range info.subFields {
+		if stmt := toProtoNamesStmtForField(field); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	return toProtoNamesFunc{
+		FuncName:         getToProtoNamesFuncName(info),
+		AllFieldsVarName: getAllFieldsVarName(info),
+		FieldStmts:       stmts,
+	}
+}
@@ -1,9 +1,10 @@
 package fieldmask
 
 import (
-	"fmt"
 	"github.com/QuangTung97/fieldmask/fields"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoimpl"
 	"reflect"
 	"strings"
 )
@@ -16,11 +17,76 @@ const (
 	fieldTypeArrayOfObjects
 	fieldTypeArrayOfPrimitives
 	fieldTypeSpecialField
+	fieldTypeMapOfObjects
+	fieldTypeStruct
+)
+
+type containerType int
+
+const (
+	containerTypeNone containerType = iota
+	containerTypeList
+	containerTypeMap
 )
 
 var ignoredImportPathPrefixes = []string{
 	"github.com/golang/protobuf/ptypes",
 	"github.com/gogo/protobuf/types",
+	"google.golang.org/protobuf/types/known",
+}
+
+// traversableWellKnownTypes are the well-known types that allow sub fields when enabled by WithWellKnownTypes.
+// Any, Empty and FieldMask are always masked as a whole
+var traversableWellKnownTypes = map[string]struct{}{
+	"Timestamp":   {},
+	"Duration":    {},
+	"DoubleValue": {},
+	"FloatValue":  {},
+	"Int64Value":  {},
+	"UInt64Value": {},
+	"Int32Value":  {},
+	"UInt32Value": {},
+	"BoolValue":   {},
+	"StringValue": {},
+	"BytesValue":  {},
+}
+
+// structWellKnownTypes are the well-known types of JSON values, whose sub fields are the keys of
+// google.protobuf.Struct when enabled by WithWellKnownTypes (fieldTypeStruct).
+// Only for fields that are not repeated, map or oneof fields
+var structWellKnownTypes = map[string]struct{}{
+	"Struct":    {},
+	"Value":     {},
+	"ListValue": {},
+}
+
+// parseContext is shared by the parse functions of a list of messages
+type parseContext struct {
+	parsedObjects map[objectKey]*objectInfo
+	opts          generateOptions
+}
+
+func newParseContext(opts generateOptions) *parseContext {
+	return &parseContext{
+		parsedObjects: map[objectKey]*objectInfo{},
+		opts:          opts,
+	}
+}
+
+func (c *parseContext) isTraversable(wellKnownTypeName string) bool {
+	if !c.opts.wellKnownTypes {
+		return false
+	}
+	_, ok := traversableWellKnownTypes[wellKnownTypeName]
+	return ok
+}
+
+func (c *parseContext) isStructType(wellKnownTypeName string) bool {
+	if !c.opts.wellKnownTypes {
+		return false
+	}
+	_, ok := structWellKnownTypes[wellKnownTypeName]
+	return ok
 }
 
 type objectInfo struct {
@@ -29,7 +95,8 @@ type objectInfo struct {
 
 	subFields []objectField
 
-	alias string // computed by generate.go
+	alias   string // computed by generate.go
+	isLocal bool   // computed by generate.go
 
 	opts *protoMsgOptions
 }
@@ -49,17 +116,27 @@ type objectKey struct {
 type objectField struct {
 	name      string
 	jsonName  string
+	protoName string
 	fieldType fieldType
 	info      *objectInfo
+
+	container  containerType
+	mapKeyType string      // only for fieldTypeMapOfObjects
+	oneof      *oneofInfo  // nil if not a member of a oneof
+	structInfo *objectInfo // only for fieldTypeStruct, the Struct, Value or ListValue type without sub fields
+}
+
+type oneofInfo struct {
+	fieldName   string // name of the interface field of the oneof
+	wrapperType string // name of the wrapper struct type of this member
 }
 
-func getJSONName(field reflect.StructField) (string, bool) {
+func getFieldNames(field reflect.StructField) (jsonName string, protoName string, ok bool) {
 	tag := field.Tag.Get("protobuf")
 	if len(tag) == 0 {
-		return "", false
+		return "", "", false
 	}
 
-	var jsonName string
 	for _, e := range strings.Split(tag, ",") {
 		kv := strings.Split(e, "=")
 		if len(kv) != 2 {
@@ -68,13 +145,16 @@ func getJSONName(field reflect.StructField) (string, bool) {
 		key := kv[0]
 		val := kv[1]
 
-		if key == "name" && len(jsonName) == 0 {
-			jsonName = val
+		if key == "name" {
+			protoName = val
 		} else if key == "json" {
 			jsonName = val
 		}
 	}
-	return jsonName, true
+	if len(jsonName) == 0 {
+		jsonName = protoName
+	}
+	return jsonName, protoName, true
 }
 
 func isSpecialPackage(importPath string) bool {
@@ -87,7 +167,7 @@ func isSpecialPackage(importPath string) bool {
 }
 
 func parseObjectInfo(
-	msgType reflect.Type, parsedObjects map[objectKey]*objectInfo,
+	msgType reflect.Type, ctx *parseContext,
 	subType *fieldType,
 ) *objectInfo {
 	obj := &objectInfo{
@@ -95,56 +175,168 @@ func parseObjectInfo(
 		importPath: msgType.PkgPath(),
 	}
 
-	existedObj, existed := parsedObjects[obj.getKey()]
+	existedObj, existed := ctx.parsedObjects[obj.getKey()]
 	if existed {
 		return existedObj
 	}
 
-	if isSpecialPackage(obj.importPath) {
+	if isSpecialPackage(obj.importPath) && !ctx.isTraversable(obj.typeName) {
 		*subType = fieldTypeSpecialField
 		return nil
 	}
 
-	obj.subFields = parseMessageFields(msgType, parsedObjects)
-	parsedObjects[obj.getKey()] = obj
+	// registered before parsing sub fields for recursive message types
+	ctx.parsedObjects[obj.getKey()] = obj
+	obj.subFields = parseMessageFields(msgType, ctx)
 	return obj
 }
 
+type parsedFieldType struct {
+	fieldType  fieldType
+	info       *objectInfo
+	container  containerType
+	mapKeyType string
+	structInfo *objectInfo
+}
+
+func parseFieldType(
+	goType reflect.Type, ctx *parseContext,
+) parsedFieldType {
+	result := parsedFieldType{
+		fieldType: fieldTypeSimple,
+	}
+
+	switch goType.Kind() {
+	case reflect.Pointer:
+		result.fieldType = fieldTypeObject
+		result.info = parseObjectInfo(goType.Elem(), ctx, &result.fieldType)
+		if result.fieldType == fieldTypeSpecialField && ctx.isStructType(goType.Elem().Name()) {
+			result.fieldType = fieldTypeStruct
+			result.structInfo = &objectInfo{
+				typeName:   goType.Elem().Name(),
+				importPath: goType.Elem().PkgPath(),
+			}
+		}
+
+	case reflect.Slice:
+		elemType := goType.Elem()
+		if elemType.Kind() == reflect.Uint8 {
+			break
+		}
+
+		result.container = containerTypeList
+		if elemType.Kind() == reflect.Pointer {
+			result.fieldType = fieldTypeArrayOfObjects
+			result.info = parseObjectInfo(elemType.Elem(), ctx, &result.fieldType)
+		} else {
+			result.fieldType = fieldTypeArrayOfPrimitives
+		}
+
+	case reflect.Map:
+		result.container = containerTypeMap
+		elemType := goType.Elem()
+		if elemType.Kind() == reflect.Pointer {
+			result.fieldType = fieldTypeMapOfObjects
+			result.info = parseObjectInfo(elemType.Elem(), ctx, &result.fieldType)
+		}
+		if result.fieldType == fieldTypeMapOfObjects {
+			result.mapKeyType = goType.Key().String()
+		}
+	}
+
+	return result
+}
+
+type oneofWrappersMessage interface {
+	XXX_OneofWrappers() []any
+}
+
+func getOneofWrapperTypes(structType reflect.Type) []reflect.Type {
+	var wrappers []any
+
+	msg := reflect.New(structType).Interface()
+	if m, ok := msg.(oneofWrappersMessage); ok {
+		wrappers = m.XXX_OneofWrappers()
+	} else if m, ok := msg.(protoreflect.ProtoMessage); ok {
+		if info, ok := m.ProtoReflect().Type().(*protoimpl.MessageInfo); ok {
+			wrappers = info.OneofWrappers
+		}
+	}
+
+	return mapSlice(wrappers, func(w any) reflect.Type {
+		return reflect.TypeOf(w)
+	})
+}
+
+func parseOneofFields(
+	field reflect.StructField, wrapperTypes []reflect.Type,
+	ctx *parseContext,
+) []objectField {
+	var result []objectField
+	for _, wrapperType := range wrapperTypes {
+		if !wrapperType.Implements(field.Type) {
+			continue
+		}
+
+		memberField := wrapperType.Elem().Field(0)
+		jsonName, protoName, ok := getFieldNames(memberField)
+		if !ok {
+			continue
+		}
+
+		parsed := parseFieldType(memberField.Type, ctx)
+		if parsed.fieldType == fieldTypeStruct {
+			parsed.fieldType = fieldTypeSpecialField
+		}
+		result = append(result, objectField{
+			name:      memberField.Name,
+			jsonName:  jsonName,
+			protoName: protoName,
+			info:      parsed.info,
+			fieldType: parsed.fieldType,
+
+			oneof: &oneofInfo{
+				fieldName:   field.Name,
+				wrapperType: wrapperType.Elem().Name(),
+			},
+		})
+	}
+	return result
+}
+
 func parseMessageFields(
-	structType reflect.Type, parsedObjects map[objectKey]*objectInfo,
+	structType reflect.Type, ctx *parseContext,
 ) []objectField {
 	var result []objectField
+	var wrapperTypes []reflect.Type
+
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 
-		jsonName, ok := getJSONName(field)
-		if !ok {
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			if wrapperTypes == nil {
+				wrapperTypes = getOneofWrapperTypes(structType)
+			}
+			result = append(result, parseOneofFields(field, wrapperTypes, ctx)...)
 			continue
 		}
 
-		var info *objectInfo
-		subType := fieldTypeSimple
-
-		switch field.Type.Kind() {
-		case reflect.Pointer:
-			subType = fieldTypeObject
-			info = parseObjectInfo(field.Type.Elem(), parsedObjects, &subType)
-
-		case reflect.Slice:
-			elemType := field.Type.Elem()
-			if elemType.Kind() == reflect.Pointer {
-				subType = fieldTypeArrayOfObjects
-				info = parseObjectInfo(elemType.Elem(), parsedObjects, &subType)
-			} else {
-				subType = fieldTypeArrayOfPrimitives
-			}
+		jsonName, protoName, ok := getFieldNames(field)
+		if !ok {
+			continue
 		}
 
+		parsed := parseFieldType(field.Type, ctx)
 		result = append(result, objectField{
 			name:      field.Name,
 			jsonName:  jsonName,
-			info:      info,
-			fieldType: subType,
+			protoName: protoName,
+			info:      parsed.info,
+			fieldType: parsed.fieldType,
+
+			container:  parsed.container,
+			mapKeyType: parsed.mapKeyType,
+			structInfo: parsed.structInfo,
 		})
 	}
 
@@ -156,6 +348,7 @@ type ProtoMessage struct {
 	protoMsg  proto.Message
 	limitedTo []fields.FieldInfo
 	opts      *protoMsgOptions
+	err       error
 }
 
 // NewProtoMessage ...
@@ -166,14 +359,12 @@ func NewProtoMessage(msg proto.Message, options ...ProtoMessageOption) ProtoMess
 	}
 }
 
-// NewProtoMessageWithFields ...
+// NewProtoMessageWithFields creates a message limited to a list of fields.
+// An invalid list of fields is reported by the generate functions
 func NewProtoMessageWithFields(
 	msg proto.Message, limitedToFields []string, options ...ProtoMessageOption,
 ) ProtoMessage {
 	limitedTo, err := fields.ComputeFieldInfos(limitedToFields)
-	if err != nil {
-		panic(err)
-	}
 
 	opts := computeProtoMsgOptions(options)
 	opts.limitedTo = limitedToFields
@@ -182,35 +373,73 @@ func NewProtoMessageWithFields(
 		protoMsg:  msg,
 		limitedTo: limitedTo,
 		opts:      opts,
+		err:       err,
+	}
+}
+
+func getMessageName(msg proto.Message) string {
+	msgType := reflect.TypeOf(msg)
+	if msgType == nil {
+		return "<nil>"
+	}
+	if msgType.Kind() == reflect.Pointer {
+		msgType = msgType.Elem()
+	}
+	return msgType.Name()
+}
+
+func checkMessageType(msg ProtoMessage) (reflect.Type, error) {
+	if msg.err != nil {
+		return nil, msg.err
+	}
+
+	msgType := reflect.TypeOf(msg.protoMsg)
+	if msgType == nil || msgType.Kind() != reflect.Pointer || msgType.Elem().Kind() != reflect.Struct {
+		return nil, ErrInvalidMessageType
 	}
+	msgType = msgType.Elem()
+
+	if isSpecialPackage(msgType.PkgPath()) {
+		return nil, ErrSpecialMessageType
+	}
+	return msgType, nil
+}
+
+func parseMessages(msgList ...ProtoMessage) ([]*objectInfo, error) {
+	return parseMessagesWithOptions(msgList, generateOptions{})
 }
 
-func parseMessages(msgList ...ProtoMessage) []*objectInfo {
+func parseMessagesWithOptions(msgList []ProtoMessage, opts generateOptions) ([]*objectInfo, error) {
 	var result []*objectInfo
+	var validMsgList []ProtoMessage
+	var errList GenerateErrors
 
-	parsedObjects := map[objectKey]*objectInfo{}
+	ctx := newParseContext(opts)
 
 	for _, msg := range msgList {
-		msgType := reflect.TypeOf(msg.protoMsg)
-		if msgType == nil || msgType.Kind() != reflect.Pointer {
-			panic("invalid message type")
-		}
-		msgType = msgType.Elem()
-
-		if isSpecialPackage(msgType.PkgPath()) {
-			panic(fmt.Sprintf("not allow type '%s'", msgType.Name()))
+		msgType, err := checkMessageType(msg)
+		if err != nil {
+			errList = append(errList, &GenerateError{
+				MessageName: getMessageName(msg.protoMsg),
+				Err:         err,
+			})
+			continue
 		}
 
-		info := parseObjectInfo(msgType, parsedObjects, nil)
+		info := parseObjectInfo(msgType, ctx, nil)
 		info.opts = msg.opts
 		result = append(result, info)
+		validMsgList = append(validMsgList, msg)
 	}
 
 	selector := newFieldSelector()
 
-	selector.traverseAll(msgList, result)
+	errList = append(errList, selector.traverseAll(validMsgList, result)...)
+	if len(errList) > 0 {
+		return nil, errList
+	}
 
-	return result
+	return result, nil
 }
 
 // ==================================
@@ -253,3 +482,31 @@ func WithFieldMapRenameType(newTypeName string) ProtoMessageOption {
 		opts.fieldMapTypeName = newTypeName
 	}
 }
+
+// ==================================
+// Generate Option
+// ==================================
+
+type generateOptions struct {
+	wellKnownTypes bool
+}
+
+func computeGenerateOptions(options []GenerateOption) generateOptions {
+	opts := generateOptions{}
+	for _, fn := range options {
+		fn(&opts)
+	}
+	return opts
+}
+
+// GenerateOption ...
+type GenerateOption func(opts *generateOptions)
+
+// WithWellKnownTypes allows sub fields of Timestamp, Duration and the wrapper types,
+// e.g. createdAt.seconds or quantity.value, and the keys of Struct, Value and ListValue fields,
+// e.g. metadata.color or metadata.address.city. Without it, those fields can only be selected as a whole
+func WithWellKnownTypes() GenerateOption {
+	return func(opts *generateOptions) {
+		opts.wellKnownTypes = true
+	}
+}
@@ -1,14 +1,17 @@
 package fieldmask
 
 import (
+	"errors"
+	"github.com/QuangTung97/fieldmask/testdata/document"
 	"github.com/QuangTung97/fieldmask/testdata/pb"
+	"github.com/QuangTung97/fieldmask/testdata/recursive"
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func TestParser_Simple_Message(t *testing.T) {
-	infos := parseMessages(NewProtoMessage(&pb.ProviderInfo{}))
+	infos := parseMessagesForTest(t, NewProtoMessage(&pb.ProviderInfo{}))
 	assert.Equal(t, 1, len(infos))
 
 	info := infos[0]
@@ -18,26 +21,30 @@ func TestParser_Simple_Message(t *testing.T) {
 
 	assert.Equal(t, []objectField{
 		{
-			name:     "Id",
-			jsonName: "id",
+			name:      "Id",
+			jsonName:  "id",
+			protoName: "id",
 		},
 		{
-			name:     "Name",
-			jsonName: "name",
+			name:      "Name",
+			jsonName:  "name",
+			protoName: "name",
 		},
 		{
-			name:     "Logo",
-			jsonName: "logo",
+			name:      "Logo",
+			jsonName:  "logo",
+			protoName: "logo",
 		},
 		{
-			name:     "ImageUrl",
-			jsonName: "imageUrl",
+			name:      "ImageUrl",
+			jsonName:  "imageUrl",
+			protoName: "image_url",
 		},
 	}, info.subFields)
 }
 
 func TestParser_Complex_Object(t *testing.T) {
-	infos := parseMessages(NewProtoMessage(&pb.Product{}))
+	infos := parseMessagesForTest(t, NewProtoMessage(&pb.Product{}))
 	assert.Equal(t, 1, len(infos))
 
 	info := infos[0]
@@ -47,12 +54,14 @@ func TestParser_Complex_Object(t *testing.T) {
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Code",
-				jsonName: "code",
+				name:      "Code",
+				jsonName:  "code",
+				protoName: "code",
 			},
 			{
-				name:     "Name",
-				jsonName: "name",
+				name:      "Name",
+				jsonName:  "name",
+				protoName: "name",
 			},
 		},
 	}
@@ -62,21 +71,26 @@ func TestParser_Complex_Object(t *testing.T) {
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Id",
-				jsonName: "id",
+				name:      "Id",
+				jsonName:  "id",
+				protoName: "id",
 			},
 			{
-				name:     "Code",
-				jsonName: "code",
+				name:      "Code",
+				jsonName:  "code",
+				protoName: "code",
 			},
 			{
-				name:     "Name",
-				jsonName: "name",
+				name:      "Name",
+				jsonName:  "name",
+				protoName: "name",
 			},
 			{
 				name:      "Options",
 				jsonName:  "options",
+				protoName: "options",
 				fieldType: fieldTypeArrayOfObjects,
+				container: containerTypeList,
 				info:      option,
 			},
 		},
@@ -86,31 +100,37 @@ func TestParser_Complex_Object(t *testing.T) {
 		{
 			name:      "Sku",
 			jsonName:  "sku",
+			protoName: "sku",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Provider",
 			jsonName:  "provider",
+			protoName: "provider",
 			fieldType: fieldTypeObject,
 			info: &objectInfo{
 				typeName:   "ProviderInfo",
 				importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 				subFields: []objectField{
 					{
-						name:     "Id",
-						jsonName: "id",
+						name:      "Id",
+						jsonName:  "id",
+						protoName: "id",
 					},
 					{
-						name:     "Name",
-						jsonName: "name",
+						name:      "Name",
+						jsonName:  "name",
+						protoName: "name",
 					},
 					{
-						name:     "Logo",
-						jsonName: "logo",
+						name:      "Logo",
+						jsonName:  "logo",
+						protoName: "logo",
 					},
 					{
-						name:     "ImageUrl",
-						jsonName: "imageUrl",
+						name:      "ImageUrl",
+						jsonName:  "imageUrl",
+						protoName: "image_url",
 					},
 				},
 			},
@@ -118,45 +138,116 @@ func TestParser_Complex_Object(t *testing.T) {
 		{
 			name:      "Attributes",
 			jsonName:  "attributes",
+			protoName: "attributes",
 			fieldType: fieldTypeArrayOfObjects,
+			container: containerTypeList,
 			info:      attribute,
 		},
 		{
 			name:      "SellerIds",
 			jsonName:  "sellerIds",
+			protoName: "seller_ids",
 			fieldType: fieldTypeArrayOfPrimitives,
+			container: containerTypeList,
 		},
 		{
 			name:      "BrandCodes",
 			jsonName:  "brandCodes",
+			protoName: "brand_codes",
 			fieldType: fieldTypeArrayOfPrimitives,
+			container: containerTypeList,
 		},
 		{
 			name:      "CreatedAt",
 			jsonName:  "createdAt",
+			protoName: "created_at",
 			fieldType: fieldTypeSpecialField,
 		},
 		{
 			name:      "Quantity",
 			jsonName:  "quantity",
+			protoName: "quantity",
 			fieldType: fieldTypeSpecialField,
 		},
 		{
 			name:      "Stocks",
 			jsonName:  "stocks",
+			protoName: "stocks",
 			fieldType: fieldTypeSpecialField,
+			container: containerTypeList,
 		},
 	}, info.subFields)
 }
 
+func parseMessagesForTest(t *testing.T, msgList ...ProtoMessage) []*objectInfo {
+	t.Helper()
+	infos, err := parseMessages(msgList...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return infos
+}
+
 func TestParser_Invalid_Type(t *testing.T) {
-	assert.PanicsWithValue(t, "invalid message type", func() {
-		parseMessages(NewProtoMessage(nil))
-	})
+	infos, err := parseMessages(NewProtoMessage(nil))
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "<nil>", Err: ErrInvalidMessageType},
+	}, err)
+	assert.Nil(t, infos)
+	assert.Equal(t, "fieldmask: message '<nil>': invalid message type", err.Error())
+}
+
+func TestParser_Invalid_Limited_Fields(t *testing.T) {
+	_, err := parseMessages(NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{"id", "name."}))
+
+	var errList GenerateErrors
+	assert.True(t, errors.As(err, &errList))
+	assert.Equal(t, 1, len(errList))
+	assert.Equal(t, "ProviderInfo", errList[0].MessageName)
+	assert.Equal(t, "", errList[0].FieldPath)
+}
+
+func TestParser_Multiple_Errors(t *testing.T) {
+	_, err := parseMessages(
+		NewProtoMessage(nil),
+		NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{"id", "xxyy"}),
+		NewProtoMessageWithFields(&pb.Product{}, []string{"sku", "provider.abcd", "createdAt.seconds"}),
+	)
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "<nil>", Err: ErrInvalidMessageType},
+		{MessageName: "ProviderInfo", FieldPath: "xxyy", Err: ErrLimitedToFieldNotFound},
+		{MessageName: "Product", FieldPath: "provider.abcd", Err: ErrLimitedToFieldNotFound},
+		{MessageName: "Product", FieldPath: "createdAt.seconds", Err: ErrLimitedToFieldNotFound},
+	}, err)
+	assert.Equal(t, "fieldmask: message '<nil>': invalid message type\n"+
+		"fieldmask: message 'ProviderInfo', field 'xxyy': limited to field not found\n"+
+		"fieldmask: message 'Product', field 'provider.abcd': limited to field not found\n"+
+		"fieldmask: message 'Product', field 'createdAt.seconds': limited to field not found",
+		err.Error(),
+	)
+}
+
+func TestParser_Multiple_Errors__Unwrap(t *testing.T) {
+	_, err := parseMessages(
+		NewProtoMessage(nil),
+		NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{"id", "xxyy"}),
+	)
+
+	assert.True(t, errors.Is(err, ErrInvalidMessageType))
+	assert.True(t, errors.Is(err, ErrLimitedToFieldNotFound))
+	assert.False(t, errors.Is(err, ErrSpecialMessageType))
+
+	var generateErr *GenerateError
+	assert.True(t, errors.As(err, &generateErr))
+	assert.Equal(t, "<nil>", generateErr.MessageName)
+
+	_, err = parseMessages(NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{"xxyy"}))
+	assert.True(t, errors.Is(err, ErrLimitedToFieldNotFound))
+	assert.False(t, errors.Is(err, ErrInvalidMessageType))
 }
 
 func TestParser_Multiple_Objects(t *testing.T) {
-	infos := parseMessages(
+	infos := parseMessagesForTest(t,
 		NewProtoMessage(&pb.ProviderInfo{}),
 		NewProtoMessage(&pb.Product{}),
 	)
@@ -174,7 +265,7 @@ func TestParser_Complex_Object__With_Limited_Fields(t *testing.T) {
 		"stocks",
 	}
 
-	infos := parseMessages(NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
+	infos := parseMessagesForTest(t, NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
 	assert.Equal(t, 1, len(infos))
 
 	info := infos[0]
@@ -184,8 +275,9 @@ func TestParser_Complex_Object__With_Limited_Fields(t *testing.T) {
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Name",
-				jsonName: "name",
+				name:      "Name",
+				jsonName:  "name",
+				protoName: "name",
 			},
 		},
 	}
@@ -195,13 +287,16 @@ func TestParser_Complex_Object__With_Limited_Fields(t *testing.T) {
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Code",
-				jsonName: "code",
+				name:      "Code",
+				jsonName:  "code",
+				protoName: "code",
 			},
 			{
 				name:      "Options",
 				jsonName:  "options",
+				protoName: "options",
 				fieldType: fieldTypeArrayOfObjects,
+				container: containerTypeList,
 				info:      option,
 			},
 		},
@@ -211,19 +306,22 @@ func TestParser_Complex_Object__With_Limited_Fields(t *testing.T) {
 		{
 			name:      "Sku",
 			jsonName:  "sku",
+			protoName: "sku",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Provider",
 			jsonName:  "provider",
+			protoName: "provider",
 			fieldType: fieldTypeObject,
 			info: &objectInfo{
 				typeName:   "ProviderInfo",
 				importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 				subFields: []objectField{
 					{
-						name:     "Name",
-						jsonName: "name",
+						name:      "Name",
+						jsonName:  "name",
+						protoName: "name",
 					},
 				},
 			},
@@ -231,25 +329,31 @@ func TestParser_Complex_Object__With_Limited_Fields(t *testing.T) {
 		{
 			name:      "Attributes",
 			jsonName:  "attributes",
+			protoName: "attributes",
 			fieldType: fieldTypeArrayOfObjects,
+			container: containerTypeList,
 			info:      attribute,
 		},
 		{
 			name:      "Stocks",
 			jsonName:  "stocks",
+			protoName: "stocks",
 			fieldType: fieldTypeSpecialField,
+			container: containerTypeList,
 		},
 	}, info.subFields)
 }
 
 func TestParser_Simple_Message__With_Limited_Fields__Not_Found_Field(t *testing.T) {
-	assert.PanicsWithValue(t, "not found field 'xxyy'", func() {
-		parseMessages(
-			NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{
-				"id", "xxyy",
-			}),
-		)
-	})
+	infos, err := parseMessages(
+		NewProtoMessageWithFields(&pb.ProviderInfo{}, []string{
+			"id", "xxyy",
+		}),
+	)
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "ProviderInfo", FieldPath: "xxyy", Err: ErrLimitedToFieldNotFound},
+	}, err)
+	assert.Nil(t, infos)
 }
 
 func TestParser_Complex_Object__With_Limited_Fields__Not_Found_Sub_Field(t *testing.T) {
@@ -261,9 +365,10 @@ func TestParser_Complex_Object__With_Limited_Fields__Not_Found_Sub_Field(t *test
 		"stocks",
 	}
 
-	assert.PanicsWithValue(t, "not found field 'attributes.options.xxyy'", func() {
-		parseMessages(NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
-	})
+	_, err := parseMessages(NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "Product", FieldPath: "attributes.options.xxyy", Err: ErrLimitedToFieldNotFound},
+	}, err)
 }
 
 func TestParser_Complex_Object__With_Only_Root_Field(t *testing.T) {
@@ -272,7 +377,7 @@ func TestParser_Complex_Object__With_Only_Root_Field(t *testing.T) {
 		"provider",
 	}
 
-	infos := parseMessages(NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
+	infos := parseMessagesForTest(t, NewProtoMessageWithFields(&pb.Product{}, limitedToFields))
 	assert.Equal(t, 1, len(infos))
 
 	info := infos[0]
@@ -281,18 +386,20 @@ func TestParser_Complex_Object__With_Only_Root_Field(t *testing.T) {
 		{
 			name:      "Sku",
 			jsonName:  "sku",
+			protoName: "sku",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Provider",
 			jsonName:  "provider",
+			protoName: "provider",
 			fieldType: fieldTypeSimple,
 		},
 	}, info.subFields)
 }
 
 func TestParser_Both_Simple_And_Complex__With_Limited_Fields(t *testing.T) {
-	infos := parseMessages(
+	infos := parseMessagesForTest(t,
 		NewProtoMessageWithFields(&pb.Product{}, []string{
 			"sku",
 			"provider",
@@ -305,11 +412,13 @@ func TestParser_Both_Simple_And_Complex__With_Limited_Fields(t *testing.T) {
 		{
 			name:      "Sku",
 			jsonName:  "sku",
+			protoName: "sku",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Provider",
 			jsonName:  "provider",
+			protoName: "provider",
 			fieldType: fieldTypeSimple,
 		},
 	}, infos[0].subFields)
@@ -318,28 +427,32 @@ func TestParser_Both_Simple_And_Complex__With_Limited_Fields(t *testing.T) {
 		{
 			name:      "Id",
 			jsonName:  "id",
+			protoName: "id",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Name",
 			jsonName:  "name",
+			protoName: "name",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "Logo",
 			jsonName:  "logo",
+			protoName: "logo",
 			fieldType: fieldTypeSimple,
 		},
 		{
 			name:      "ImageUrl",
 			jsonName:  "imageUrl",
+			protoName: "image_url",
 			fieldType: fieldTypeSimple,
 		},
 	}, infos[1].subFields)
 }
 
 func TestParser_Both_Simple_And_Complex__With_Limited_Fields__Conflicted(t *testing.T) {
-	infos := parseMessages(
+	infos := parseMessagesForTest(t,
 		NewProtoMessageWithFields(&pb.Product{}, []string{
 			"sku",
 			"provider.id",
@@ -353,12 +466,14 @@ func TestParser_Both_Simple_And_Complex__With_Limited_Fields__Conflicted(t *test
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Id",
-				jsonName: "id",
+				name:      "Id",
+				jsonName:  "id",
+				protoName: "id",
 			},
 			{
-				name:     "Name",
-				jsonName: "name",
+				name:      "Name",
+				jsonName:  "name",
+				protoName: "name",
 			},
 		},
 		opts: &protoMsgOptions{
@@ -371,12 +486,14 @@ func TestParser_Both_Simple_And_Complex__With_Limited_Fields__Conflicted(t *test
 		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 		subFields: []objectField{
 			{
-				name:     "Sku",
-				jsonName: "sku",
+				name:      "Sku",
+				jsonName:  "sku",
+				protoName: "sku",
 			},
 			{
 				name:      "Provider",
 				jsonName:  "provider",
+				protoName: "provider",
 				fieldType: fieldTypeObject,
 				info:      provider,
 			},
@@ -389,7 +506,250 @@ func TestParser_Both_Simple_And_Complex__With_Limited_Fields__Conflicted(t *test
 }
 
 func TestParser_Special_Type(t *testing.T) {
-	assert.PanicsWithValue(t, "not allow type 'DoubleValue'", func() {
-		parseMessages(NewProtoMessage(&types.DoubleValue{}))
+	_, err := parseMessages(NewProtoMessage(&types.DoubleValue{}))
+	assert.Equal(t, GenerateErrors{
+		{MessageName: "DoubleValue", Err: ErrSpecialMessageType},
+	}, err)
+}
+
+func TestParser_Oneof_Fields(t *testing.T) {
+	infos := parseMessagesForTest(t, NewProtoMessage(&pb.Item{}))
+	assert.Equal(t, 1, len(infos))
+
+	book := &objectInfo{
+		typeName:   "Book",
+		importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
+		subFields: []objectField{
+			{
+				name:      "Isbn",
+				jsonName:  "isbn",
+				protoName: "isbn",
+			},
+			{
+				name:      "Title",
+				jsonName:  "title",
+				protoName: "title",
+			},
+			{
+				name:      "Publisher",
+				jsonName:  "publisher",
+				protoName: "publisher",
+				fieldType: fieldTypeObject,
+				info:      parseMessagesForTest(t, NewProtoMessage(&pb.ProviderInfo{}))[0],
+			},
+		},
+	}
+	book.subFields[2].info.opts = nil
+
+	assert.Equal(t, []objectField{
+		{
+			name:      "Id",
+			jsonName:  "id",
+			protoName: "id",
+		},
+		{
+			name:      "Name",
+			jsonName:  "name",
+			protoName: "name",
+			oneof: &oneofInfo{
+				fieldName:   "Payload",
+				wrapperType: "Item_Name",
+			},
+		},
+		{
+			name:      "Book",
+			jsonName:  "book",
+			protoName: "book",
+			fieldType: fieldTypeObject,
+			info:      book,
+			oneof: &oneofInfo{
+				fieldName:   "Payload",
+				wrapperType: "Item_Book",
+			},
+		},
+		{
+			name:      "ReleasedAt",
+			jsonName:  "releasedAt",
+			protoName: "released_at",
+			fieldType: fieldTypeSpecialField,
+			oneof: &oneofInfo{
+				fieldName:   "Payload",
+				wrapperType: "Item_ReleasedAt",
+			},
+		},
+		{
+			name:      "Quantity",
+			jsonName:  "quantity",
+			protoName: "quantity",
+		},
+	}, infos[0].subFields)
+}
+
+func TestParser_Map_Fields(t *testing.T) {
+	infos := parseMessagesForTest(t,
+		NewProtoMessage(&pb.ProviderInfo{}),
+		NewProtoMessage(&pb.Attribute{}),
+		NewProtoMessage(&pb.Catalog{}),
+	)
+	assert.Equal(t, 3, len(infos))
+
+	assert.Equal(t, []objectField{
+		{
+			name:      "Code",
+			jsonName:  "code",
+			protoName: "code",
+		},
+		{
+			name:       "AttributesByCode",
+			jsonName:   "attributesByCode",
+			protoName:  "attributes_by_code",
+			fieldType:  fieldTypeMapOfObjects,
+			container:  containerTypeMap,
+			info:       infos[1],
+			mapKeyType: "string",
+		},
+		{
+			name:       "Providers",
+			jsonName:   "providers",
+			protoName:  "providers",
+			fieldType:  fieldTypeMapOfObjects,
+			container:  containerTypeMap,
+			info:       infos[0],
+			mapKeyType: "int32",
+		},
+		{
+			name:      "Labels",
+			jsonName:  "labels",
+			protoName: "labels",
+			container: containerTypeMap,
+		},
+		{
+			name:      "UpdatedTimes",
+			jsonName:  "updatedTimes",
+			protoName: "updated_times",
+			fieldType: fieldTypeSpecialField,
+			container: containerTypeMap,
+		},
+	}, infos[2].subFields)
+}
+
+func TestParser_Well_Known_Types(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		infos := parseMessagesForTest(t, NewProtoMessage(&pb.Product{}))
+		assert.Equal(t, fieldTypeSpecialField, infos[0].subFields[5].fieldType)
+		assert.Nil(t, infos[0].subFields[5].info)
 	})
+
+	t.Run("enabled", func(t *testing.T) {
+		infos, err := parseMessagesWithOptions(
+			[]ProtoMessage{NewProtoMessage(&pb.Product{})},
+			generateOptions{wellKnownTypes: true},
+		)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, objectField{
+			name:      "CreatedAt",
+			jsonName:  "createdAt",
+			protoName: "created_at",
+			fieldType: fieldTypeObject,
+			info: &objectInfo{
+				typeName:   "Timestamp",
+				importPath: "github.com/gogo/protobuf/types",
+				subFields: []objectField{
+					{name: "Seconds", jsonName: "seconds", protoName: "seconds"},
+					{name: "Nanos", jsonName: "nanos", protoName: "nanos"},
+				},
+			},
+		}, infos[0].subFields[5])
+
+		stocks := infos[0].subFields[7]
+		assert.Equal(t, fieldTypeArrayOfObjects, stocks.fieldType)
+		assert.Equal(t, "Int32Value", stocks.info.typeName)
+	})
+
+	t.Run("root message is still not allowed", func(t *testing.T) {
+		_, err := parseMessagesWithOptions(
+			[]ProtoMessage{NewProtoMessage(&types.Timestamp{})},
+			generateOptions{wellKnownTypes: true},
+		)
+		assert.Equal(t, GenerateErrors{
+			{MessageName: "Timestamp", Err: ErrSpecialMessageType},
+		}, err)
+	})
+}
+
+func TestParser_Well_Known_Types__Struct(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		infos := parseMessagesForTest(t, NewProtoMessage(&document.Document{}))
+		assert.Equal(t, fieldTypeSpecialField, infos[0].subFields[1].fieldType)
+		assert.Nil(t, infos[0].subFields[1].structInfo)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		infos, err := parseMessagesWithOptions(
+			[]ProtoMessage{NewProtoMessage(&document.Document{})},
+			generateOptions{wellKnownTypes: true},
+		)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, objectField{
+			name:      "Metadata",
+			jsonName:  "metadata",
+			protoName: "metadata",
+			fieldType: fieldTypeStruct,
+			structInfo: &objectInfo{
+				typeName:   "Struct",
+				importPath: "github.com/gogo/protobuf/types",
+			},
+		}, infos[0].subFields[1])
+
+		assert.Equal(t, fieldTypeStruct, infos[0].subFields[2].fieldType)
+		assert.Equal(t, "Value", infos[0].subFields[2].structInfo.typeName)
+
+		assert.Equal(t, fieldTypeStruct, infos[0].subFields[3].fieldType)
+		assert.Equal(t, "ListValue", infos[0].subFields[3].structInfo.typeName)
+
+		// repeated structs are masked as a whole
+		assert.Equal(t, fieldTypeSpecialField, infos[0].subFields[4].fieldType)
+		assert.Nil(t, infos[0].subFields[4].structInfo)
+	})
+}
+
+func TestParser_Recursive_Types(t *testing.T) {
+	infos := parseMessagesForTest(t,
+		NewProtoMessage(&recursive.Category{}),
+		NewProtoMessage(&recursive.Thread{}),
+	)
+	assert.Equal(t, 2, len(infos))
+
+	category := infos[0]
+	assert.Equal(t, "Category", category.typeName)
+	assert.Equal(t, fieldTypeObject, category.subFields[2].fieldType)
+	assert.Same(t, category, category.subFields[2].info)
+	assert.Equal(t, fieldTypeArrayOfObjects, category.subFields[3].fieldType)
+	assert.Same(t, category, category.subFields[3].info)
+
+	thread := infos[1]
+	comment := thread.subFields[1].info
+	assert.Equal(t, "Comment", comment.typeName)
+	assert.Same(t, thread, comment.subFields[2].info)
+}
+
+func TestParser_Recursive_Types__With_Limited_Fields(t *testing.T) {
+	infos := parseMessagesForTest(t,
+		NewProtoMessageWithFields(&recursive.Category{}, []string{"name", "children.name"}),
+	)
+
+	category := infos[0]
+	assert.Equal(t, []objectField{
+		{name: "Name", jsonName: "name", protoName: "name"},
+		{
+			name:      "Children",
+			jsonName:  "children",
+			protoName: "children",
+			fieldType: fieldTypeArrayOfObjects,
+			info:      category,
+			container: containerTypeList,
+		},
+	}, category.subFields)
 }
@@ -0,0 +1,58 @@
+package fieldmask
+
+import (
+	"fmt"
+)
+
+type pathBuilder struct {
+	TypeName        string
+	MessageName     string
+	WholeMethodName string
+	Methods         []pathMethod
+}
+
+type pathMethod struct {
+	Name     string
+	JSONName string
+
+	// BuilderTypeName is the path builder of the sub message, empty for fields without sub fields
+	BuilderTypeName string
+}
+
+func getPathBuilderTypeName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_Paths", e.alias, e.typeName)
+}
+
+func getPathsVarName(e *objectInfo) string {
+	return e.typeName + "Paths"
+}
+
+// getWholePathMethodName returns the name of the method returning the path of the whole message,
+// with a trailing underscore when a field has the same name, like protoc-gen-go does for conflicts
+func getWholePathMethodName(info *objectInfo) string {
+	name := "Path"
+	for _, f := range info.subFields {
+		if f.name == name {
+			return name + "_"
+		}
+	}
+	return name
+}
+
+func buildPathBuilder(info *objectInfo) pathBuilder {
+	return pathBuilder{
+		TypeName:        getPathBuilderTypeName(info),
+		MessageName:     info.typeName,
+		WholeMethodName: getWholePathMethodName(info),
+		Methods: mapSlice(info.subFields, func(f objectField) pathMethod {
+			method := pathMethod{
+				Name:     f.name,
+				JSONName: f.jsonName,
+			}
+			if f.info != nil {
+				method.BuilderTypeName = getPathBuilderTypeName(f.info)
+			}
+			return method
+		}),
+	}
+}
@@ -0,0 +1,308 @@
+package fieldmask
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const wellKnownTypesPrefix = "google.protobuf."
+
+func isSpecialMessage(msg *protogen.Message) bool {
+	if strings.HasPrefix(string(msg.Desc.FullName()), wellKnownTypesPrefix) {
+		return true
+	}
+	return isSpecialPackage(string(msg.GoIdent.GoImportPath))
+}
+
+func isTraversableMessage(ctx *parseContext, msg *protogen.Message) bool {
+	fullName := string(msg.Desc.FullName())
+	if !strings.HasPrefix(fullName, wellKnownTypesPrefix) {
+		return false
+	}
+	return ctx.isTraversable(strings.TrimPrefix(fullName, wellKnownTypesPrefix))
+}
+
+func isStructMessage(ctx *parseContext, msg *protogen.Message) bool {
+	fullName := string(msg.Desc.FullName())
+	if !strings.HasPrefix(fullName, wellKnownTypesPrefix) {
+		return false
+	}
+	return ctx.isStructType(strings.TrimPrefix(fullName, wellKnownTypesPrefix))
+}
+
+func getMapKeyGoType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	default:
+		return "string"
+	}
+}
+
+func parseDescriptorObjectInfo(
+	msg *protogen.Message, ctx *parseContext,
+	subType *fieldType,
+) *objectInfo {
+	obj := &objectInfo{
+		typeName:   msg.GoIdent.GoName,
+		importPath: string(msg.GoIdent.GoImportPath),
+	}
+
+	existedObj, existed := ctx.parsedObjects[obj.getKey()]
+	if existed {
+		return existedObj
+	}
+
+	if isSpecialMessage(msg) && !isTraversableMessage(ctx, msg) {
+		*subType = fieldTypeSpecialField
+		return nil
+	}
+
+	// registered before parsing sub fields for recursive message types
+	ctx.parsedObjects[obj.getKey()] = obj
+	obj.subFields = parseDescriptorFields(msg, ctx)
+	return obj
+}
+
+// parseDescriptorStructInfo returns the type of a Struct, Value or ListValue field whose keys can be masked
+func parseDescriptorStructInfo(msg *protogen.Message, ctx *parseContext, subType *fieldType) *objectInfo {
+	if *subType != fieldTypeSpecialField || !isStructMessage(ctx, msg) {
+		return nil
+	}
+	*subType = fieldTypeStruct
+	return &objectInfo{
+		typeName:   msg.GoIdent.GoName,
+		importPath: string(msg.GoIdent.GoImportPath),
+	}
+}
+
+func parseDescriptorFields(
+	msg *protogen.Message, ctx *parseContext,
+) []objectField {
+	var result []objectField
+	for _, field := range msg.Fields {
+		var oneof *oneofInfo
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			oneof = &oneofInfo{
+				fieldName:   field.Oneof.GoName,
+				wrapperType: field.GoIdent.GoName,
+			}
+		}
+
+		var info *objectInfo
+		var structInfo *objectInfo
+		subType := fieldTypeSimple
+		container := containerTypeNone
+		mapKeyType := ""
+
+		switch {
+		case field.Desc.IsMap():
+			container = containerTypeMap
+			keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+			if valueField.Message != nil {
+				subType = fieldTypeMapOfObjects
+				info = parseDescriptorObjectInfo(valueField.Message, ctx, &subType)
+			}
+			if subType == fieldTypeMapOfObjects {
+				mapKeyType = getMapKeyGoType(keyField.Desc.Kind())
+			}
+
+		case field.Desc.IsList():
+			container = containerTypeList
+			if field.Message != nil {
+				subType = fieldTypeArrayOfObjects
+				info = parseDescriptorObjectInfo(field.Message, ctx, &subType)
+			} else {
+				subType = fieldTypeArrayOfPrimitives
+			}
+
+		case field.Message != nil:
+			subType = fieldTypeObject
+			info = parseDescriptorObjectInfo(field.Message, ctx, &subType)
+			if oneof == nil {
+				structInfo = parseDescriptorStructInfo(field.Message, ctx, &subType)
+			}
+		}
+
+		result = append(result, objectField{
+			name:      field.GoName,
+			jsonName:  field.Desc.JSONName(),
+			protoName: string(field.Desc.Name()),
+			info:      info,
+			fieldType: subType,
+
+			container:  container,
+			mapKeyType: mapKeyType,
+			oneof:      oneof,
+			structInfo: structInfo,
+		})
+	}
+	return result
+}
+
+func collectFileMessages(messages []*protogen.Message) []*protogen.Message {
+	var result []*protogen.Message
+	for _, msg := range messages {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		result = append(result, msg)
+		result = append(result, collectFileMessages(msg.Messages)...)
+	}
+	return result
+}
+
+func parseDescriptorMessages(messages []*protogen.Message, opts generateOptions) []*objectInfo {
+	return parseDescriptorMessagesWithContext(messages, newParseContext(opts))
+}
+
+func parseDescriptorMessagesWithContext(messages []*protogen.Message, ctx *parseContext) []*objectInfo {
+	result := make([]*objectInfo, 0, len(messages))
+	for _, msg := range messages {
+		if isSpecialMessage(msg) {
+			continue
+		}
+		result = append(result, parseDescriptorObjectInfo(msg, ctx, nil))
+	}
+	return result
+}
+
+// groupFilesByPackage returns the files to generate grouped by Go package, in the order of the request
+func groupFilesByPackage(files []*protogen.File) [][]*protogen.File {
+	var result [][]*protogen.File
+	packageIndex := map[protogen.GoImportPath]int{}
+	for _, file := range files {
+		if !file.Generate {
+			continue
+		}
+		index, existed := packageIndex[file.GoImportPath]
+		if !existed {
+			index = len(result)
+			packageIndex[file.GoImportPath] = index
+			result = append(result, nil)
+		}
+		result[index] = append(result[index], file)
+	}
+	return result
+}
+
+// packageHelpers decides which file of a Go package contains the helpers of each message, to declare them only once:
+// the file defining the message, or the first file using it for the messages of the other files
+type packageHelpers struct {
+	definedIn      map[objectKey]int
+	claimed        map[objectKey]struct{}
+	claimedStructs map[string]struct{}
+}
+
+func newPackageHelpers(fileInfos [][]*objectInfo) *packageHelpers {
+	h := &packageHelpers{
+		definedIn:      map[objectKey]int{},
+		claimed:        map[objectKey]struct{}{},
+		claimedStructs: map[string]struct{}{},
+	}
+	for fileIndex, infos := range fileInfos {
+		for _, info := range infos {
+			h.definedIn[info.getKey()] = fileIndex
+		}
+	}
+	return h
+}
+
+func (h *packageHelpers) fileHelperInfos(fileIndex int, infos []*objectInfo) []*objectInfo {
+	var result []*objectInfo
+	for _, info := range traverseAllObjectInfos(infos) {
+		key := info.getKey()
+		if definedIndex, ok := h.definedIn[key]; ok {
+			if definedIndex == fileIndex {
+				result = append(result, info)
+			}
+			continue
+		}
+		if _, ok := h.claimed[key]; ok {
+			continue
+		}
+		h.claimed[key] = struct{}{}
+		result = append(result, info)
+	}
+	return result
+}
+
+func (h *packageHelpers) fileStructInfos(helperInfos []*objectInfo) []*objectInfo {
+	var result []*objectInfo
+	for _, info := range collectStructInfos(helperInfos) {
+		if _, ok := h.claimedStructs[info.importPath]; ok {
+			continue
+		}
+		h.claimedStructs[info.importPath] = struct{}{}
+		result = append(result, info)
+	}
+	return result
+}
+
+func generatePluginPackage(gen *protogen.Plugin, files []*protogen.File, opts generateOptions) error {
+	local := localPackage{
+		name:       string(files[0].GoPackageName),
+		importPath: string(files[0].GoImportPath),
+	}
+
+	ctx := newParseContext(opts)
+	fileInfos := make([][]*objectInfo, 0, len(files))
+	var allInputInfos []*objectInfo
+	for _, file := range files {
+		infos := parseDescriptorMessagesWithContext(collectFileMessages(file.Messages), ctx)
+		fileInfos = append(fileInfos, infos)
+		allInputInfos = append(allInputInfos, infos...)
+	}
+
+	// the aliases are computed for the whole package, for the same helper names in all files
+	allInfos := traverseAllObjectInfos(allInputInfos)
+	computeImports(append(allInfos[:len(allInfos):len(allInfos)], collectStructInfos(allInfos)...), local)
+	fieldMaskSet := computeFieldMaskSet(allInputInfos, allInfos)
+
+	helpers := newPackageHelpers(fileInfos)
+	for fileIndex, file := range files {
+		infos := fileInfos[fileIndex]
+		if len(infos) == 0 {
+			continue
+		}
+
+		helperInfos := helpers.fileHelperInfos(fileIndex, infos)
+		structInfos := helpers.fileStructInfos(helperInfos)
+
+		generatedFile := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_fieldmask.pb.go", file.GoImportPath)
+		err := generateFileCode(generatedFile, fileContent{
+			inputInfos:   infos,
+			helperInfos:  helperInfos,
+			structInfos:  structInfos,
+			fieldMaskSet: fieldMaskSet,
+			imports:      computeFileImports(helperInfos, structInfos),
+		}, local)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GeneratePlugin generates field masks for all messages in the files to generate of a protoc plugin request.
+// The generated code is written to the same package as the .pb.go files, with suffix '_fieldmask.pb.go'.
+// The helpers of a message are generated only once for each Go package, to allow many files in the same package
+func GeneratePlugin(gen *protogen.Plugin, options ...GenerateOption) error {
+	opts := computeGenerateOptions(options)
+	for _, files := range groupFilesByPackage(gen.Files) {
+		if err := generatePluginPackage(gen, files, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,469 @@
+package fieldmask
+
+import (
+	_ "embed"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+func findFileDescriptorProtos(t *testing.T, path string) []*descriptorpb.FileDescriptorProto {
+	file, err := protoregistry.GlobalFiles.FindFileByPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result []*descriptorpb.FileDescriptorProto
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		result = append(result, findFileDescriptorProtos(t, imports.Get(i).Path())...)
+	}
+	return append(result, protodesc.ToFileDescriptorProto(file))
+}
+
+func newPluginForTest(t *testing.T, fileName string, param string) *protogen.Plugin {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fileName},
+		Parameter:      &param,
+		ProtoFile:      findFileDescriptorProtos(t, fileName),
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+const gogoTypesPluginParam = "Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types;types," +
+	"Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types;types"
+
+func runPluginForTest(t *testing.T, fileName string, param string) *pluginpb.CodeGeneratorResponse {
+	gen := newPluginForTest(t, fileName, param)
+
+	err := GeneratePlugin(gen)
+	assert.Equal(t, nil, err)
+
+	return gen.Response()
+}
+
+//go:embed testdata/pb/message_fieldmask.pb.go
+var pluginGeneratedCode string
+
+func TestGeneratePlugin(t *testing.T) {
+	resp := runPluginForTest(t, "message.proto", gogoTypesPluginParam)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 1, len(resp.File))
+	assert.Equal(t, "github.com/QuangTung97/fieldmask/testdata/pb/message_fieldmask.pb.go", resp.File[0].GetName())
+	assert.Equal(t, pluginGeneratedCode, resp.File[0].GetContent())
+}
+
+func TestGeneratePlugin_Well_Known_Types_Only(t *testing.T) {
+	resp := runPluginForTest(t, "google/protobuf/timestamp.proto", "")
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 0, len(resp.File))
+}
+
+func TestParseDescriptorMessages__Same_As_Parse_Messages(t *testing.T) {
+	gen := newPluginForTest(t, "message.proto", gogoTypesPluginParam)
+
+	infos := parseDescriptorMessages(collectFileMessages(gen.FilesByPath["message.proto"].Messages), generateOptions{})
+	assert.Equal(t, 7, len(infos))
+
+	expected := parseMessagesForTest(t,
+		NewProtoMessage(&pb.Product{}),
+		NewProtoMessage(&pb.Item{}),
+		NewProtoMessage(&pb.Catalog{}),
+	)
+	for _, info := range expected {
+		info.opts = nil
+	}
+
+	assert.Equal(t, expected[0], infos[3])
+	assert.Same(t, infos[0], infos[3].subFields[1].info)
+
+	assert.Equal(t, expected[1], infos[5])
+	assert.Same(t, infos[4], infos[5].subFields[2].info)
+
+	assert.Equal(t, expected[2], infos[6])
+	assert.Same(t, infos[2], infos[6].subFields[1].info)
+}
+
+func TestParseDescriptorMessages__Without_Go_Package_Mapping(t *testing.T) {
+	gen := newPluginForTest(t, "message.proto", "")
+
+	infos := parseDescriptorMessages(collectFileMessages(gen.FilesByPath["message.proto"].Messages), generateOptions{})
+	assert.Equal(t, 7, len(infos))
+
+	product := infos[3]
+	assert.Equal(t, "CreatedAt", product.subFields[5].name)
+	assert.Equal(t, fieldTypeSpecialField, product.subFields[5].fieldType)
+	assert.Nil(t, product.subFields[5].info)
+
+	assert.Equal(t, "Stocks", product.subFields[7].name)
+	assert.Equal(t, fieldTypeSpecialField, product.subFields[7].fieldType)
+	assert.Nil(t, product.subFields[7].info)
+}
+
+func TestParseDescriptorMessages__With_Well_Known_Types(t *testing.T) {
+	opts := generateOptions{wellKnownTypes: true}
+
+	t.Run("same as parse messages", func(t *testing.T) {
+		gen := newPluginForTest(t, "message.proto", gogoTypesPluginParam)
+
+		infos := parseDescriptorMessages(collectFileMessages(gen.FilesByPath["message.proto"].Messages), opts)
+		assert.Equal(t, 7, len(infos))
+
+		expected, err := parseMessagesWithOptions([]ProtoMessage{NewProtoMessage(&pb.Product{})}, opts)
+		assert.Equal(t, nil, err)
+		expected[0].opts = nil
+
+		assert.Equal(t, expected[0], infos[3])
+	})
+
+	t.Run("without go package mapping", func(t *testing.T) {
+		gen := newPluginForTest(t, "message.proto", "")
+
+		infos := parseDescriptorMessages(collectFileMessages(gen.FilesByPath["message.proto"].Messages), opts)
+
+		createdAt := infos[3].subFields[5]
+		assert.Equal(t, fieldTypeObject, createdAt.fieldType)
+		assert.Equal(t, "google.golang.org/protobuf/types/known/timestamppb", createdAt.info.importPath)
+		assert.Equal(t, []string{"seconds", "nanos"}, mapSlice(createdAt.info.subFields, func(f objectField) string {
+			return f.jsonName
+		}))
+	})
+}
+
+func newRecursiveFileDescriptorProto() *descriptorpb.FileDescriptorProto {
+	type fieldProto = descriptorpb.FieldDescriptorProto
+
+	messageField := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label) *fieldProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".tree.v1.Category"),
+		}
+	}
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("tree.proto"),
+		Package: proto.String("tree.v1"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("github.com/QuangTung97/fieldmask/testdata/tree;tree"),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Category"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("name"),
+						JsonName: proto.String("name"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					},
+					messageField("parent", 2, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					messageField("children", 3, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+				},
+			},
+		},
+	}
+}
+
+func TestGeneratePlugin_Recursive_Types(t *testing.T) {
+	fileName := "tree.proto"
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fileName},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{newRecursiveFileDescriptorProto()},
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	assert.Equal(t, nil, err)
+
+	infos := parseDescriptorMessages(collectFileMessages(gen.FilesByPath[fileName].Messages), generateOptions{})
+	assert.Equal(t, 1, len(infos))
+	assert.Same(t, infos[0], infos[0].subFields[1].info)
+	assert.Same(t, infos[0], infos[0].subFields[2].info)
+
+	err = GeneratePlugin(gen)
+	assert.Equal(t, nil, err)
+
+	resp := gen.Response()
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 1, len(resp.File))
+	assert.Contains(t, resp.File[0].GetContent(), "Children *tree_Category_Mask")
+}
+
+func newDocumentFileDescriptorProto() *descriptorpb.FileDescriptorProto {
+	messageField := func(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(typeName),
+		}
+	}
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("document.proto"),
+		Package:    proto.String("document.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/struct.proto"},
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("github.com/QuangTung97/fieldmask/testdata/document;document"),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Document"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					messageField("metadata", 1, ".google.protobuf.Struct"),
+					messageField("extra", 2, ".google.protobuf.Value"),
+				},
+			},
+		},
+	}
+}
+
+func TestGeneratePlugin_Struct_Keys(t *testing.T) {
+	fileName := "document.proto"
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fileName},
+		Parameter:      proto.String("well_known_types=true"),
+		ProtoFile: append(
+			findFileDescriptorProtos(t, "google/protobuf/struct.proto"),
+			newDocumentFileDescriptorProto(),
+		),
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	assert.Equal(t, nil, err)
+
+	messages := collectFileMessages(gen.FilesByPath[fileName].Messages)
+
+	infos := parseDescriptorMessages(messages, generateOptions{})
+	assert.Equal(t, fieldTypeSpecialField, infos[0].subFields[0].fieldType)
+	assert.Nil(t, infos[0].subFields[0].structInfo)
+
+	infos = parseDescriptorMessages(messages, generateOptions{wellKnownTypes: true})
+	assert.Equal(t, objectField{
+		name:      "Metadata",
+		jsonName:  "metadata",
+		protoName: "metadata",
+		fieldType: fieldTypeStruct,
+		structInfo: &objectInfo{
+			typeName:   "Struct",
+			importPath: "google.golang.org/protobuf/types/known/structpb",
+		},
+	}, infos[0].subFields[0])
+	assert.Equal(t, "Value", infos[0].subFields[1].structInfo.typeName)
+
+	err = GeneratePlugin(gen, WithWellKnownTypes())
+	assert.Equal(t, nil, err)
+
+	resp := gen.Response()
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 1, len(resp.File))
+	assert.Contains(t, resp.File[0].GetContent(), "func pb1_Struct_KeepKeys(")
+}
+
+func newShopFileDescriptorProtos() []*descriptorpb.FileDescriptorProto {
+	field := func(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}
+		if typeName != "" {
+			f.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+
+	options := &descriptorpb.FileOptions{
+		GoPackage: proto.String("github.com/QuangTung97/fieldmask/testdata/shop;shop"),
+	}
+
+	return []*descriptorpb.FileDescriptorProto{
+		{
+			Name:       proto.String("shop/provider.proto"),
+			Package:    proto.String("shop.v1"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"google/protobuf/timestamp.proto"},
+			Options:    options,
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Provider"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("id", 1, ""),
+						field("created_at", 2, ".google.protobuf.Timestamp"),
+					},
+				},
+			},
+		},
+		{
+			Name:    proto.String("shop/product.proto"),
+			Package: proto.String("shop.v1"),
+			Syntax:  proto.String("proto3"),
+			Dependency: []string{
+				"google/protobuf/timestamp.proto",
+				"shop/provider.proto",
+			},
+			Options: options,
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Product"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("sku", 1, ""),
+						field("provider", 2, ".shop.v1.Provider"),
+						field("updated_at", 3, ".google.protobuf.Timestamp"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseGeneratedFileForTest returns the names of the top level declarations,
+// and checks that all imports are used
+func parseGeneratedFileForTest(t *testing.T, content string) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkImportsUsedForTest(t, file)
+
+	var names []string
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+			if funcDecl.Recv == nil {
+				names = append(names, funcDecl.Name.Name)
+			}
+			continue
+		}
+		for _, spec := range decl.(*ast.GenDecl).Specs {
+			names = append(names, getSpecNamesForTest(spec)...)
+		}
+	}
+	return names
+}
+
+func getSpecNamesForTest(spec ast.Spec) []string {
+	switch spec := spec.(type) {
+	case *ast.TypeSpec:
+		return []string{spec.Name.Name}
+	case *ast.ValueSpec:
+		return mapSlice(spec.Names, func(name *ast.Ident) string {
+			return name.Name
+		})
+	default:
+		return nil
+	}
+}
+
+func checkImportsUsedForTest(t *testing.T, file *ast.File) {
+	usedNames := map[string]struct{}{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			usedNames[ident.Name] = struct{}{}
+		}
+		return true
+	})
+
+	for _, imp := range file.Imports {
+		importPath := strings.Trim(imp.Path.Value, `"`)
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		_, used := usedNames[name]
+		assert.True(t, used, "import %s is not used", importPath)
+	}
+}
+
+func TestGeneratePlugin_Many_Files_Same_Package(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"shop/provider.proto", "shop/product.proto"},
+		Parameter:      proto.String("well_known_types=true"),
+		ProtoFile: append(
+			findFileDescriptorProtos(t, "google/protobuf/timestamp.proto"),
+			newShopFileDescriptorProtos()...,
+		),
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	assert.Equal(t, nil, err)
+
+	err = GeneratePlugin(gen, WithWellKnownTypes())
+	assert.Equal(t, nil, err)
+
+	resp := gen.Response()
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 2, len(resp.File))
+
+	providerFile := resp.File[0].GetContent()
+	productFile := resp.File[1].GetContent()
+
+	providerNames := parseGeneratedFileForTest(t, providerFile)
+	productNames := parseGeneratedFileForTest(t, productFile)
+
+	declared := map[string]struct{}{}
+	for _, name := range providerNames {
+		declared[name] = struct{}{}
+	}
+	for _, name := range productNames {
+		_, existed := declared[name]
+		assert.False(t, existed, "%s is declared in both files", name)
+	}
+
+	// helpers of a message are in the file defining it
+	assert.Contains(t, providerNames, "shop_Provider_Keep")
+	assert.Contains(t, productNames, "shop_Product_Keep")
+	assert.Contains(t, productFile, "shop_Provider_Keep(m.Provider, ")
+
+	// helpers of the other packages are in the first file using them
+	assert.Contains(t, providerNames, "pb1_Timestamp_Keep")
+	assert.Contains(t, productFile, "pb1_Timestamp_Keep(m.UpdatedAt, ")
+	assert.Contains(t, productFile, `pb1 "google.golang.org/protobuf/types/known/timestamppb"`)
+
+	assert.Contains(t, providerNames, "ProviderFieldMask")
+	assert.NotContains(t, productNames, "ProviderFieldMask")
+	assert.Contains(t, productNames, "ProductFieldMask")
+	assert.Contains(t, productFile, "func (fm *ProductFieldMask) ProviderMask() *ProviderFieldMask {")
+
+	assert.Contains(t, providerNames, "TimestampFieldMask")
+	assert.NotContains(t, productNames, "TimestampFieldMask")
+	assert.Contains(t, productFile, "func (fm *ProductFieldMask) UpdatedAtMask() *TimestampFieldMask {")
+	assert.NotContains(t, providerNames, "NewTimestampFieldMask")
+}
@@ -0,0 +1,164 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+type selection struct {
+	TypeName           string
+	MessageName        string
+	MaskTypeName       string
+	SelectedMethodName string
+	Methods            []selectionMethod
+
+	HasPathFuncName string
+	HasPathCases    []computeMaskCase
+}
+
+type selectionMethod struct {
+	Name       string
+	ReturnType string
+	Body       string
+}
+
+// fieldMaskMethodNames are the names of the methods of the generated XxxFieldMask,
+// fields with the same names are only accessible through Selection()
+var fieldMaskMethodNames = map[string]struct{}{
+	"Mask":            {},
+	"MaskInto":        {},
+	"MaskSlice":       {},
+	"MaskInPlace":     {},
+	"Apply":           {},
+	"GetMaskedFields": {},
+	"ToProto":         {},
+	"Has":             {},
+	"HasAny":          {},
+	"Selection":       {},
+}
+
+func getSelectionTypeName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_Selection", e.alias, e.typeName)
+}
+
+func getHasPathFuncName(e *objectInfo) string {
+	return fmt.Sprintf("%s_%s_HasPath", e.alias, e.typeName)
+}
+
+// getBitExprOf is the same as getBitExpr, but for the mask variable maskVar
+func getBitExprOf(maskVar string, index int) string {
+	return fmt.Sprintf("%s.bits[%d]&(1<<%d) != 0", maskVar, index/64, index%64)
+}
+
+func getNoBitExpr(index int) string {
+	return getNoBitExprOf("m", index)
+}
+
+func getNoBitExprOf(maskVar string, index int) string {
+	return fmt.Sprintf("%s.bits[%d]&(1<<%d) == 0", maskVar, index/64, index%64)
+}
+
+// getSelectedMethodName returns the name of the method checking whether the message is selected,
+// with a trailing underscore when a field has the same name
+func getSelectedMethodName(info *objectInfo) string {
+	name := "Selected"
+	for _, f := range info.subFields {
+		if f.name == name {
+			return name + "_"
+		}
+	}
+	return name
+}
+
+func selectionMethodForField(index int, field objectField) selectionMethod {
+	if field.info == nil {
+		return selectionMethod{
+			Name:       field.name,
+			ReturnType: "bool",
+			Body:       fmt.Sprintf("return s.selected && (s.mask == nil || %s)", getBitExprOf("s.mask", index)),
+		}
+	}
+
+	subType := getSelectionTypeName(field.info)
+	body := fmt.Sprintf(`
+if s.mask == nil {
+	return %s{selected: s.selected}
+}
+return %s{selected: %s, mask: s.mask.%s}
+`,
+		subType,
+		subType, getBitExprOf("s.mask", index), field.name,
+	)
+	return selectionMethod{
+		Name:       field.name,
+		ReturnType: subType,
+		Body:       strings.TrimSpace(body),
+	}
+}
+
+func hasPathStmtForField(index int, field objectField) string {
+	if field.fieldType == fieldTypeStruct {
+		return hasPathStmtForStruct(index, field)
+	}
+	if field.info == nil {
+		return fmt.Sprintf("return len(path) == 1 && (m == nil || %s)", getBitExpr(index))
+	}
+
+	result := fmt.Sprintf(`
+if m == nil {
+	return len(path) == 1 || %s(nil, path[1:], partial)
+}
+if %s {
+	return false
+}
+if len(path) == 1 {
+	return partial || m.%s == nil
+}
+return %s(m.%s, path[1:], partial)
+`,
+		getHasPathFuncName(field.info),
+		getNoBitExpr(index),
+		field.name,
+		getHasPathFuncName(field.info), field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func buildSelection(info *objectInfo) selection {
+	methods := make([]selectionMethod, 0, len(info.subFields))
+	cases := make([]computeMaskCase, 0, len(info.subFields))
+
+	for index, field := range info.subFields {
+		methods = append(methods, selectionMethodForField(index, field))
+		cases = append(cases, computeMaskCase{
+			CaseNames: getCaseNames(field),
+			Stmt:      hasPathStmtForField(index, field),
+		})
+	}
+
+	return selection{
+		TypeName:           getSelectionTypeName(info),
+		MessageName:        info.typeName,
+		MaskTypeName:       getMaskTypeName(info),
+		SelectedMethodName: getSelectedMethodName(info),
+		Methods:            methods,
+
+		HasPathFuncName: getHasPathFuncName(info),
+		HasPathCases:    cases,
+	}
+}
+
+// buildFieldMaskAccessors returns the methods of XxxFieldMask delegating to Selection(),
+// except for fields conflicting with the other methods
+func buildFieldMaskAccessors(info *objectInfo) []selectionMethod {
+	var result []selectionMethod
+	for index, field := range info.subFields {
+		if _, ok := fieldMaskMethodNames[field.name]; ok {
+			continue
+		}
+		method := selectionMethodForField(index, field)
+		method.Body = fmt.Sprintf("return fm.Selection().%s()", field.name)
+		result = append(result, method)
+	}
+	return result
+}
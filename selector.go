@@ -1,7 +1,6 @@
 package fieldmask
 
 import (
-	"fmt"
 	"github.com/QuangTung97/fieldmask/fields"
 )
 
@@ -42,8 +41,9 @@ func (s *fieldSelector) getSelectedFields(k objectKey) selectedFields {
 	return selected
 }
 
-func (s *fieldSelector) traverse(info *objectInfo, limitedTo []fields.FieldInfo) {
-	s.traverseRecursive(info, limitedTo, "")
+// traverse returns the paths of limited to fields that are not found
+func (s *fieldSelector) traverse(info *objectInfo, limitedTo []fields.FieldInfo) []string {
+	return s.traverseRecursive(info, limitedTo, "")
 }
 
 func (s *fieldSelector) keepSelectedFields(info *objectInfo) {
@@ -73,30 +73,37 @@ func (s *fieldSelector) keepSelectedFields(info *objectInfo) {
 	info.subFields = keptFields
 }
 
-func (s *fieldSelector) traverseRecursive(info *objectInfo, limitedTo []fields.FieldInfo, prefix string) {
+func (s *fieldSelector) traverseRecursive(info *objectInfo, limitedTo []fields.FieldInfo, prefix string) []string {
 	if info == nil {
-		return
+		return nil
 	}
 
 	k := info.getKey()
 	selected := s.getSelectedFields(k)
 
 	if len(limitedTo) == 0 {
-		return
+		return nil
 	}
 
 	fieldMap := getObjectInfoFieldMap(info)
 
+	var notFound []string
 	for _, f := range limitedTo {
 		selected.selectedSet[f.FieldName] = struct{}{}
 
 		subInfo, ok := fieldMap[f.FieldName]
 		if !ok {
-			panic(fmt.Sprintf("not found field '%s'", prefix+f.FieldName))
+			notFound = append(notFound, prefix+f.FieldName)
+			continue
+		}
+		if subInfo.info == nil && subInfo.fieldType != fieldTypeStruct && len(f.SubFields) > 0 {
+			notFound = append(notFound, prefix+f.FieldName+"."+f.SubFields[0].FieldName)
+			continue
 		}
 
-		s.traverseRecursive(subInfo.info, f.SubFields, prefix+f.FieldName+".")
+		notFound = append(notFound, s.traverseRecursive(subInfo.info, f.SubFields, prefix+f.FieldName+".")...)
 	}
+	return notFound
 }
 
 func (s *fieldSelector) allowAll(info *objectInfo) bool {
@@ -108,15 +115,26 @@ func (s *fieldSelector) allowField(info *objectInfo, jsonName string) bool {
 	return ok
 }
 
-func (s *fieldSelector) traverseAll(msgList []ProtoMessage, infos []*objectInfo) {
+func (s *fieldSelector) traverseAll(msgList []ProtoMessage, infos []*objectInfo) GenerateErrors {
+	var errList GenerateErrors
 	for i, msg := range msgList {
-		s.traverse(infos[i], msg.limitedTo)
+		for _, path := range s.traverse(infos[i], msg.limitedTo) {
+			errList = append(errList, &GenerateError{
+				MessageName: infos[i].typeName,
+				FieldPath:   path,
+				Err:         ErrLimitedToFieldNotFound,
+			})
+		}
+	}
+	if len(errList) > 0 {
+		return errList
 	}
 
 	allInfos := traverseAllObjectInfos(infos)
 	for _, info := range allInfos {
 		s.keepSelectedFields(info)
 	}
+	return nil
 }
 
 // traverseAllObjectInfos list all infos
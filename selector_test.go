@@ -15,20 +15,24 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
-					name:     "Logo",
-					jsonName: "logo",
+					name:      "Logo",
+					jsonName:  "logo",
+					protoName: "logo",
 				},
 				{
-					name:     "ImageUrl",
-					jsonName: "imageUrl",
+					name:      "ImageUrl",
+					jsonName:  "imageUrl",
+					protoName: "image_url",
 				},
 			},
 		}
@@ -50,20 +54,24 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
-					name:     "Logo",
-					jsonName: "logo",
+					name:      "Logo",
+					jsonName:  "logo",
+					protoName: "logo",
 				},
 				{
-					name:     "ImageUrl",
-					jsonName: "imageUrl",
+					name:      "ImageUrl",
+					jsonName:  "imageUrl",
+					protoName: "image_url",
 				},
 			},
 		}
@@ -87,12 +95,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Logo",
-					jsonName: "logo",
+					name:      "Logo",
+					jsonName:  "logo",
+					protoName: "logo",
 				},
 			},
 		}
@@ -107,12 +117,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -122,23 +134,27 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Provider",
 					jsonName:  "provider",
+					protoName: "provider",
 					fieldType: fieldTypeObject,
 					info:      provider,
 				},
 				{
 					name:      "Quantity",
 					jsonName:  "quantity",
+					protoName: "quantity",
 					fieldType: fieldTypeSpecialField,
 				},
 				{
 					name:      "Stocks",
 					jsonName:  "stocks",
+					protoName: "stocks",
 					fieldType: fieldTypeSpecialField,
 				},
 			},
@@ -180,8 +196,9 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -192,18 +209,21 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Provider",
 					jsonName:  "provider",
+					protoName: "provider",
 					fieldType: fieldTypeObject,
 					info:      newProvider,
 				},
 				{
 					name:      "Stocks",
 					jsonName:  "stocks",
+					protoName: "stocks",
 					fieldType: fieldTypeSpecialField,
 				},
 			},
@@ -220,12 +240,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -235,23 +257,27 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Provider",
 					jsonName:  "provider",
+					protoName: "provider",
 					fieldType: fieldTypeObject,
 					info:      provider,
 				},
 				{
 					name:      "Quantity",
 					jsonName:  "quantity",
+					protoName: "quantity",
 					fieldType: fieldTypeSpecialField,
 				},
 				{
 					name:      "Stocks",
 					jsonName:  "stocks",
+					protoName: "stocks",
 					fieldType: fieldTypeSpecialField,
 				},
 			},
@@ -292,31 +318,34 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
-					name:     "Logo",
-					jsonName: "logo",
+					name:      "Logo",
+					jsonName:  "logo",
+					protoName: "logo",
 				},
 				{
-					name:     "ImageUrl",
-					jsonName: "imageUrl",
+					name:      "ImageUrl",
+					jsonName:  "imageUrl",
+					protoName: "image_url",
 				},
 			},
 		}
 
-		assert.PanicsWithValue(t, "not found field 'xxyy'", func() {
-			s.traverse(info, []fields.FieldInfo{
-				{
-					FieldName: "xxyy",
-				},
-			})
+		notFound := s.traverse(info, []fields.FieldInfo{
+			{
+				FieldName: "xxyy",
+			},
 		})
+		assert.Equal(t, []string{"xxyy"}, notFound)
 	})
 
 	t.Run("not found field in nested", func(t *testing.T) {
@@ -327,12 +356,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Code",
-					jsonName: "code",
+					name:      "Code",
+					jsonName:  "code",
+					protoName: "code",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -342,20 +373,24 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Code",
-					jsonName: "code",
+					name:      "Code",
+					jsonName:  "code",
+					protoName: "code",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
 					name:      "Options",
 					jsonName:  "options",
+					protoName: "options",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      option,
 				},
@@ -367,35 +402,36 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Attributes",
 					jsonName:  "attributes",
+					protoName: "attributes",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      attribute,
 				},
 			},
 		}
 
-		assert.PanicsWithValue(t, "not found field 'attributes.options.hello'", func() {
-			s.traverse(product, []fields.FieldInfo{
-				{
-					FieldName: "attributes",
-					SubFields: []fields.FieldInfo{
-						{FieldName: "name"},
-						{
-							FieldName: "options",
-							SubFields: []fields.FieldInfo{
-								{FieldName: "code"},
-								{FieldName: "hello"},
-							},
+		notFound := s.traverse(product, []fields.FieldInfo{
+			{
+				FieldName: "attributes",
+				SubFields: []fields.FieldInfo{
+					{FieldName: "name"},
+					{
+						FieldName: "options",
+						SubFields: []fields.FieldInfo{
+							{FieldName: "code"},
+							{FieldName: "hello"},
 						},
 					},
 				},
-			})
+			},
 		})
+		assert.Equal(t, []string{"attributes.options.hello"}, notFound)
 	})
 
 	t.Run("outer allow all inner fields", func(t *testing.T) {
@@ -406,12 +442,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -421,23 +459,27 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Provider",
 					jsonName:  "provider",
+					protoName: "provider",
 					fieldType: fieldTypeObject,
 					info:      provider,
 				},
 				{
 					name:      "Quantity",
 					jsonName:  "quantity",
+					protoName: "quantity",
 					fieldType: fieldTypeSpecialField,
 				},
 				{
 					name:      "Stocks",
 					jsonName:  "stocks",
+					protoName: "stocks",
 					fieldType: fieldTypeSpecialField,
 				},
 			},
@@ -461,17 +503,20 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Provider",
 					jsonName:  "provider",
+					protoName: "provider",
 					fieldType: fieldTypeSimple,
 				},
 				{
 					name:      "Stocks",
 					jsonName:  "stocks",
+					protoName: "stocks",
 					fieldType: fieldTypeSpecialField,
 				},
 			},
@@ -488,12 +533,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Code",
-					jsonName: "code",
+					name:      "Code",
+					jsonName:  "code",
+					protoName: "code",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 			},
 		}
@@ -503,20 +550,24 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Id",
-					jsonName: "id",
+					name:      "Id",
+					jsonName:  "id",
+					protoName: "id",
 				},
 				{
-					name:     "Code",
-					jsonName: "code",
+					name:      "Code",
+					jsonName:  "code",
+					protoName: "code",
 				},
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
 					name:      "Options",
 					jsonName:  "options",
+					protoName: "options",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      option,
 				},
@@ -528,12 +579,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Sku",
-					jsonName: "sku",
+					name:      "Sku",
+					jsonName:  "sku",
+					protoName: "sku",
 				},
 				{
 					name:      "Attributes",
 					jsonName:  "attributes",
+					protoName: "attributes",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      attribute,
 				},
@@ -565,8 +618,9 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Code",
-					jsonName: "code",
+					name:      "Code",
+					jsonName:  "code",
+					protoName: "code",
 				},
 			},
 		}
@@ -576,12 +630,14 @@ func TestFieldSelector(t *testing.T) {
 			importPath: "github.com/QuangTung97/fieldmask/testdata/pb",
 			subFields: []objectField{
 				{
-					name:     "Name",
-					jsonName: "name",
+					name:      "Name",
+					jsonName:  "name",
+					protoName: "name",
 				},
 				{
 					name:      "Options",
 					jsonName:  "options",
+					protoName: "options",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      newOption,
 				},
@@ -595,6 +651,7 @@ func TestFieldSelector(t *testing.T) {
 				{
 					name:      "Attributes",
 					jsonName:  "attributes",
+					protoName: "attributes",
 					fieldType: fieldTypeArrayOfObjects,
 					info:      newAttr,
 				},
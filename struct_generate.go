@@ -0,0 +1,147 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+// structFunc is the helper functions of the Struct, Value and ListValue types of a package,
+// masking the keys of google.protobuf.Struct
+type structFunc struct {
+	StructType         string
+	ValueType          string
+	ListValueType      string
+	StructValueWrapper string
+	ListValueWrapper   string
+
+	StructKeepKeysName    string
+	ValueKeepKeysName     string
+	ListValueKeepKeysName string
+	StructApplyKeysName   string
+	ValueApplyKeysName    string
+}
+
+// collectStructInfos returns the types of all fields of fieldTypeStruct, to be imported
+func collectStructInfos(infos []*objectInfo) []*objectInfo {
+	var result []*objectInfo
+	for _, info := range infos {
+		for _, field := range info.subFields {
+			if field.structInfo != nil {
+				result = append(result, field.structInfo)
+			}
+		}
+	}
+	return result
+}
+
+func getKeepKeysFuncName(structInfo *objectInfo) string {
+	return fmt.Sprintf("%s_%s_KeepKeys", structInfo.alias, structInfo.typeName)
+}
+
+func getApplyKeysFuncName(structInfo *objectInfo) string {
+	return fmt.Sprintf("%s_%s_ApplyKeys", structInfo.alias, structInfo.typeName)
+}
+
+// structInfoOf returns the type typeName in the same package as structInfo
+func structInfoOf(structInfo *objectInfo, typeName string) *objectInfo {
+	return &objectInfo{
+		typeName:   typeName,
+		importPath: structInfo.importPath,
+		alias:      structInfo.alias,
+		isLocal:    structInfo.isLocal,
+	}
+}
+
+// buildStructFuncs returns the helper functions for each package of the struct types
+func buildStructFuncs(structInfos []*objectInfo) []structFunc {
+	var result []structFunc
+	generated := map[string]struct{}{}
+	for _, info := range structInfos {
+		if _, existed := generated[info.importPath]; existed {
+			continue
+		}
+		generated[info.importPath] = struct{}{}
+
+		structType := structInfoOf(info, "Struct")
+		valueType := structInfoOf(info, "Value")
+		listValueType := structInfoOf(info, "ListValue")
+
+		result = append(result, structFunc{
+			StructType:         getQualifiedTypeName(structType),
+			ValueType:          getQualifiedTypeName(valueType),
+			ListValueType:      getQualifiedTypeName(listValueType),
+			StructValueWrapper: getQualifiedTypeName(structInfoOf(info, "Value_StructValue")),
+			ListValueWrapper:   getQualifiedTypeName(structInfoOf(info, "Value_ListValue")),
+
+			StructKeepKeysName:    getKeepKeysFuncName(structType),
+			ValueKeepKeysName:     getKeepKeysFuncName(valueType),
+			ListValueKeepKeysName: getKeepKeysFuncName(listValueType),
+			StructApplyKeysName:   getApplyKeysFuncName(structType),
+			ValueApplyKeysName:    getApplyKeysFuncName(valueType),
+		})
+	}
+	return result
+}
+
+func computeMaskStmtForStruct(index int, field objectField) string {
+	result := fmt.Sprintf(`
+isSimpleField = false
+%s
+m.%s = field.SubFields
+`,
+		getSetBitStmt(index),
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+// applyKeysStmtForStruct updates the selected keys of a struct field with a non-nil list of keys
+func applyKeysStmtForStruct(field objectField) string {
+	if field.structInfo.typeName == "ListValue" {
+		return fmt.Sprintf("dst.%s = %s(m.%s, src.%s)",
+			field.name, getKeepKeysFuncName(field.structInfo), field.name, field.name,
+		)
+	}
+	return fmt.Sprintf("dst.%s = %s(m.%s, dst.%s, src.%s)",
+		field.name, getApplyKeysFuncName(field.structInfo), field.name, field.name, field.name,
+	)
+}
+
+func hasPathStmtForStruct(index int, field objectField) string {
+	result := fmt.Sprintf(`
+if m == nil {
+	return true
+}
+if %s {
+	return false
+}
+if m.%s == nil || fields.HasWildcard(m.%s) {
+	return true
+}
+if len(path) == 1 {
+	return partial
+}
+subFields, ok := fields.SubTree(m.%s, strings.Join(path[1:], "."))
+return ok && (partial || len(subFields) == 0)
+`,
+		getNoBitExpr(index),
+		field.name, field.name,
+		field.name,
+	)
+	return strings.TrimSpace(result)
+}
+
+func includeStmtForStructField(field objectField) string {
+	result := fmt.Sprintf(`
+if subFields, ok := excluded["%s"]; !ok {
+	result = append(result, fields.FieldInfo{FieldName: "%s"})
+} else if len(subFields) > 0 {
+	return nil, fields.ErrSubtractFromWholeField("%s")
+}
+`,
+		field.jsonName,
+		field.jsonName,
+		field.jsonName,
+	)
+	return strings.TrimSpace(result)
+}
@@ -0,0 +1,70 @@
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+)
+
+func getFieldMaskStructName(e *objectInfo) string {
+	return e.typeName + "FieldMask"
+}
+
+func subMaskMethodForField(index int, field objectField) selectionMethod {
+	subStruct := getFieldMaskStructName(field.info)
+	body := fmt.Sprintf(`
+if fm.mask == nil {
+	return &%s{applyOptions: fm.applyOptions}
+}
+if %s {
+	return nil
+}
+maskedFields, _ := fields.SubTree(fm.maskedFields, %q)
+return &%s{
+	mask:         fm.mask.%s,
+	applyOptions: fm.applyOptions,
+	maskedFields: maskedFields,
+}
+`,
+		subStruct,
+		getNoBitExprOf("fm.mask", index),
+		field.jsonName,
+		subStruct,
+		field.name,
+	)
+	return selectionMethod{
+		Name:       field.name + "Mask",
+		ReturnType: "*" + subStruct,
+		Body:       strings.TrimSpace(body),
+	}
+}
+
+// buildSubMaskMethods returns the methods of XxxFieldMask extracting the field masks of the sub messages,
+// including the values of repeated and map fields. Only generated for sub messages having their own XxxFieldMask,
+// and without conflicting with the other methods
+func buildSubMaskMethods(
+	info *objectInfo, fieldMaskSet map[objectKey]struct{}, accessors []selectionMethod,
+) []selectionMethod {
+	usedNames := map[string]struct{}{}
+	for name := range fieldMaskMethodNames {
+		usedNames[name] = struct{}{}
+	}
+	for _, accessor := range accessors {
+		usedNames[accessor.Name] = struct{}{}
+	}
+
+	var result []selectionMethod
+	for index, field := range info.subFields {
+		if field.info == nil {
+			continue
+		}
+		if _, ok := fieldMaskSet[field.info.getKey()]; !ok {
+			continue
+		}
+		method := subMaskMethodForField(index, field)
+		if _, ok := usedNames[method.Name]; ok {
+			continue
+		}
+		result = append(result, method)
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+// Package document contains a hand-written proto message with JSON value fields, equivalent to:
+//
+//	message Document {
+//	  string id = 1;
+//	  google.protobuf.Struct metadata = 2;
+//	  google.protobuf.Value extra = 3;
+//	  google.protobuf.ListValue tags = 4;
+//	  repeated google.protobuf.Struct history = 5;
+//	  bool to_proto = 6;
+//	}
+package document
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// Document ...
+type Document struct {
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata *types.Struct    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Extra    *types.Value     `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	Tags     *types.ListValue `protobuf:"bytes,4,opt,name=tags,proto3" json:"tags,omitempty"`
+	History  []*types.Struct  `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	ToProto  bool             `protobuf:"varint,6,opt,name=to_proto,json=toProto,proto3" json:"to_proto,omitempty"`
+}
+
+// Reset ...
+func (m *Document) Reset() { *m = Document{} }
+
+// String ...
+func (m *Document) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage ...
+func (*Document) ProtoMessage() {}
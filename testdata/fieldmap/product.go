@@ -57,3 +57,17 @@ type OptionFieldMap struct {
 func (f OptionFieldMap) GetRoot() Field {
 	return f.Root
 }
+
+type CatalogFieldMap struct {
+	Root Field
+
+	Code             Field                `json:"code"`
+	AttributesByCode AttributeFieldMap    `json:"attributesByCode"`
+	Providers        ProviderDataFieldMap `json:"providers"`
+	Labels           Field                `json:"labels"`
+	UpdatedTimes     Field                `json:"updatedTimes"`
+}
+
+func (f CatalogFieldMap) GetRoot() Field {
+	return f.Root
+}
@@ -3,48 +3,244 @@
 package generated
 
 import (
+	"strings"
+
 	"github.com/QuangTung97/fieldmask/fields"
 	pb "github.com/QuangTung97/fieldmask/testdata/pb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type ProviderInfoFieldMask struct {
-	keepFunc     func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo)
+	mask         *pb_ProviderInfo_Mask
+	applyOptions fields.ApplyOptions
 	maskedFields []fields.FieldInfo
 }
 
 func NewProviderInfoFieldMask(maskedFields []string, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	fieldInfos, err := computeProviderInfoFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
+
+func computeProviderInfoFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
 	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
 	if err != nil {
 		return nil, err
 	}
+	return normalizeProviderInfoFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeProviderInfoFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_ProviderInfo_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// ProviderInfoFieldMaskCache caches the field masks of NewProviderInfoFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ProviderInfoFieldMaskCache struct {
+	cache   *fields.Cache[*ProviderInfoFieldMask]
+	options []fields.Option
+}
+
+// NewProviderInfoFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewProviderInfoFieldMaskCache(cache *fields.Cache[*ProviderInfoFieldMask], options ...fields.Option) *ProviderInfoFieldMaskCache {
+	return &ProviderInfoFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewProviderInfoFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ProviderInfoFieldMaskCache) Get(maskedFields []string) (*ProviderInfoFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeProviderInfoFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ProviderInfoFieldMask, error) {
+		return newProviderInfoFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewProviderInfoFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewProviderInfoFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeProviderInfoFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
+
+// NewProviderInfoFieldMaskFromPaths creates a field mask from the paths built by ProviderInfoPaths
+func NewProviderInfoFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	return NewProviderInfoFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewProviderInfoExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewProviderInfoExcludeMask(excludedFields []string, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeProviderInfoFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_ProviderInfo_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
 
-	keepFunc, err := pb_ProviderInfo_ComputeKeepFunc(fieldInfos)
+func newProviderInfoFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ProviderInfoFieldMask, error) {
+	mask, err := pb_ProviderInfo_ComputeMask(fieldInfos)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ProviderInfoFieldMask{
-		keepFunc:     keepFunc,
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
 		maskedFields: fieldInfos,
 	}, nil
 }
 
 func (fm *ProviderInfoFieldMask) Mask(msg *pb.ProviderInfo) *pb.ProviderInfo {
 	newMsg := &pb.ProviderInfo{}
-	fm.keepFunc(newMsg, msg)
+	pb_ProviderInfo_Keep(fm.mask, newMsg, msg)
 	return newMsg
 }
 
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProviderInfoFieldMask) MaskInto(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProviderInfoFieldMask) MaskSlice(dst []*pb.ProviderInfo, src []*pb.ProviderInfo) []*pb.ProviderInfo {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.ProviderInfo
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.ProviderInfo{}
+		}
+		pb_ProviderInfo_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProviderInfoFieldMask) MaskInPlace(msg *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProviderInfoFieldMask) Apply(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
 func (fm *ProviderInfoFieldMask) GetMaskedFields() []fields.FieldInfo {
 	return fm.maskedFields
 }
 
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProviderInfoFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_ProviderInfo_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProviderInfoFieldMask) Has(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProviderInfoFieldMask) HasAny(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProviderInfoFieldMask) Selection() pb_ProviderInfo_Selection {
+	return pb_ProviderInfo_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ProviderInfoFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *ProviderInfoFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *ProviderInfoFieldMask) Logo() bool {
+	return fm.Selection().Logo()
+}
+
+func (fm *ProviderInfoFieldMask) ImageUrl() bool {
+	return fm.Selection().ImageUrl()
+}
+
 type ProductFieldMask struct {
-	keepFunc     func(newMsg *pb.Product, msg *pb.Product)
+	mask         *pb_Product_Mask
+	applyOptions fields.ApplyOptions
 	maskedFields []fields.FieldInfo
 }
 
 func NewProductFieldMask(maskedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	fieldInfos, err := computeProductFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
+
+func computeProductFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
 	opts := []fields.Option{
 		fields.WithLimitedToFields([]string{
 			"sku",
@@ -59,230 +255,1578 @@ func NewProductFieldMask(maskedFields []string, options ...fields.Option) (*Prod
 	if err != nil {
 		return nil, err
 	}
+	return normalizeProductFieldMaskInfos(fieldInfos, options)
+}
 
-	keepFunc, err := pb_Product_ComputeKeepFunc(fieldInfos)
+func normalizeProductFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Product_NormalizeFieldNames(fieldInfos, style)
 	if err != nil {
 		return nil, err
 	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
 
-	return &ProductFieldMask{
-		keepFunc:     keepFunc,
-		maskedFields: fieldInfos,
-	}, nil
+// ProductFieldMaskCache caches the field masks of NewProductFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ProductFieldMaskCache struct {
+	cache   *fields.Cache[*ProductFieldMask]
+	options []fields.Option
 }
 
-func (fm *ProductFieldMask) Mask(msg *pb.Product) *pb.Product {
-	newMsg := &pb.Product{}
-	fm.keepFunc(newMsg, msg)
-	return newMsg
+// NewProductFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewProductFieldMaskCache(cache *fields.Cache[*ProductFieldMask], options ...fields.Option) *ProductFieldMaskCache {
+	return &ProductFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
 }
 
-func (fm *ProductFieldMask) GetMaskedFields() []fields.FieldInfo {
-	return fm.maskedFields
+// Get is the same as NewProductFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ProductFieldMaskCache) Get(maskedFields []string) (*ProductFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeProductFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ProductFieldMask, error) {
+		return newProductFieldMask(fieldInfos, c.options)
+	})
 }
 
-func pb_ProviderInfo_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-			*newMsg = *msg
-		}, nil
+// NewProductFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewProductFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ProductFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	opts := []fields.Option{
+		fields.WithLimitedToFields([]string{
+			"sku",
+			"provider",
+			"attributes.options.code",
+			"stocks",
+		}),
 	}
+	options = append(opts, options...)
 
-	var subFuncs []func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+	fieldInfos, err = normalizeProductFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
 
-		switch field.FieldName {
-		case "id":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Id)
-		case "name":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Name)
-		case "logo":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Logo)
-		case "imageUrl":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_ImageUrl)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+// NewProductFieldMaskFromPaths creates a field mask from the paths built by ProductPaths
+func NewProductFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ProductFieldMask, error) {
+	return NewProductFieldMask(fields.PathStrings(paths...), options...)
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
+// NewProductExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewProductExcludeMask(excludedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	opts := []fields.Option{
+		fields.WithLimitedToFields([]string{
+			"sku",
+			"provider",
+			"attributes.options.code",
+			"stocks",
+		}),
+	}
+	options = append(opts, options...)
+
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
 	}
 
-	return func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
-}
+	excludedInfos, err = normalizeProductFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Product_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
 
-func pb_Product_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Product, msg *pb.Product), error) {
 	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Product, msg *pb.Product) {
-			*newMsg = *msg
-		}, nil
+		return nil, fields.ErrExcludeAllFields
 	}
+	return newProductFieldMask(fieldInfos, options)
+}
 
-	var subFuncs []func(newMsg *pb.Product, msg *pb.Product)
+func newProductFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ProductFieldMask, error) {
+	mask, err := pb_Product_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+	return &ProductFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
 
-		switch field.FieldName {
-		case "sku":
-			subFuncs = append(subFuncs, pb_Product_Keep_Sku)
-		case "provider":
-			subFuncs = append(subFuncs, pb_Product_Keep_Provider)
-		case "attributes":
-			isSimpleField = false
-			keepFunc, err := pb_Attribute_ComputeKeepFunc(field.SubFields)
-			if err != nil {
-				return nil, fields.PrependParentField(err, "attributes")
-			}
-			subFuncs = append(subFuncs, func(newMsg *pb.Product, msg *pb.Product) {
-				msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
-				for _, e := range msg.Attributes {
-					newSubMsg := &pb.Attribute{}
-					keepFunc(newSubMsg, e)
-					msgList = append(msgList, newSubMsg)
-				}
-				newMsg.Attributes = msgList
-			})
-		case "stocks":
-			subFuncs = append(subFuncs, pb_Product_Keep_Stocks)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+func (fm *ProductFieldMask) Mask(msg *pb.Product) *pb.Product {
+	newMsg := &pb.Product{}
+	pb_Product_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProductFieldMask) MaskInto(dst *pb.Product, src *pb.Product) {
+	pb_Product_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProductFieldMask) MaskSlice(dst []*pb.Product, src []*pb.Product) []*pb.Product {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
 		}
+		var newMsg *pb.Product
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Product{}
+		}
+		pb_Product_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
 	}
+	return result
+}
 
-	return func(newMsg *pb.Product, msg *pb.Product) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProductFieldMask) MaskInPlace(msg *pb.Product) {
+	pb_Product_KeepInto(fm.mask, msg, msg)
 }
 
-func pb_Attribute_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Attribute, msg *pb.Attribute), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Attribute, msg *pb.Attribute) {
-			*newMsg = *msg
-		}, nil
-	}
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProductFieldMask) Apply(dst *pb.Product, src *pb.Product) {
+	pb_Product_Apply(fm.mask, dst, src, fm.applyOptions)
+}
 
-	var subFuncs []func(newMsg *pb.Attribute, msg *pb.Attribute)
+func (fm *ProductFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProductFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Product_ToProtoNames(fm.maskedFields))
+}
 
-		switch field.FieldName {
-		case "options":
-			isSimpleField = false
-			keepFunc, err := pb_Option_ComputeKeepFunc(field.SubFields)
-			if err != nil {
-				return nil, fields.PrependParentField(err, "options")
-			}
-			subFuncs = append(subFuncs, func(newMsg *pb.Attribute, msg *pb.Attribute) {
-				msgList := make([]*pb.Option, 0, len(msg.Options))
-				for _, e := range msg.Options {
-					newSubMsg := &pb.Option{}
-					keepFunc(newSubMsg, e)
-					msgList = append(msgList, newSubMsg)
-				}
-				newMsg.Options = msgList
-			})
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProductFieldMask) Has(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), false)
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
-	}
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProductFieldMask) HasAny(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), true)
+}
 
-	return func(newMsg *pb.Attribute, msg *pb.Attribute) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProductFieldMask) Selection() pb_Product_Selection {
+	return pb_Product_Selection{selected: true, mask: fm.mask}
 }
 
-func pb_Option_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Option, msg *pb.Option), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Option, msg *pb.Option) {
-			*newMsg = *msg
-		}, nil
-	}
+func (fm *ProductFieldMask) Sku() bool {
+	return fm.Selection().Sku()
+}
 
-	var subFuncs []func(newMsg *pb.Option, msg *pb.Option)
+func (fm *ProductFieldMask) Provider() bool {
+	return fm.Selection().Provider()
+}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+func (fm *ProductFieldMask) Attributes() pb_Attribute_Selection {
+	return fm.Selection().Attributes()
+}
 
-		switch field.FieldName {
-		case "code":
-			subFuncs = append(subFuncs, pb_Option_Keep_Code)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+func (fm *ProductFieldMask) Stocks() bool {
+	return fm.Selection().Stocks()
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
+// AttributesMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) AttributesMask() *AttributeFieldMask {
+	if fm.mask == nil {
+		return &AttributeFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
 	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "attributes")
+	return &AttributeFieldMask{
+		mask:         fm.mask.Attributes,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type AttributeFieldMask struct {
+	mask         *pb_Attribute_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *AttributeFieldMask) Mask(msg *pb.Attribute) *pb.Attribute {
+	newMsg := &pb.Attribute{}
+	pb_Attribute_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
 
-	return func(newMsg *pb.Option, msg *pb.Option) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *AttributeFieldMask) MaskInto(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *AttributeFieldMask) MaskSlice(dst []*pb.Attribute, src []*pb.Attribute) []*pb.Attribute {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
 		}
-	}, nil
+		var newMsg *pb.Attribute
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Attribute{}
+		}
+		pb_Attribute_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
 }
 
-// =========================================
-// ProviderInfo Keep Functions
-// =========================================
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *AttributeFieldMask) MaskInPlace(msg *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, msg, msg)
+}
 
-func pb_ProviderInfo_Keep_Id(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Id = msg.Id
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *AttributeFieldMask) Apply(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_Apply(fm.mask, dst, src, fm.applyOptions)
 }
 
-func pb_ProviderInfo_Keep_Name(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Name = msg.Name
+func (fm *AttributeFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
 }
 
-func pb_ProviderInfo_Keep_Logo(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Logo = msg.Logo
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *AttributeFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Attribute_ToProtoNames(fm.maskedFields))
 }
 
-func pb_ProviderInfo_Keep_ImageUrl(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.ImageUrl = msg.ImageUrl
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *AttributeFieldMask) Has(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), false)
 }
 
-// =========================================
-// Product Keep Functions
-// =========================================
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *AttributeFieldMask) HasAny(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), true)
+}
 
-func pb_Product_Keep_Sku(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Sku = msg.Sku
+// Selection returns the type-safe queries of the selected fields
+func (fm *AttributeFieldMask) Selection() pb_Attribute_Selection {
+	return pb_Attribute_Selection{selected: true, mask: fm.mask}
 }
 
-func pb_Product_Keep_Provider(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Provider = msg.Provider
+func (fm *AttributeFieldMask) Options() pb_Option_Selection {
+	return fm.Selection().Options()
 }
 
-func pb_Product_Keep_Stocks(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Stocks = msg.Stocks
+// OptionsMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *AttributeFieldMask) OptionsMask() *OptionFieldMask {
+	if fm.mask == nil {
+		return &OptionFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<0) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "options")
+	return &OptionFieldMask{
+		mask:         fm.mask.Options,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
 }
 
-// =========================================
-// Option Keep Functions
-// =========================================
+type OptionFieldMask struct {
+	mask         *pb_Option_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
 
-func pb_Option_Keep_Code(newMsg *pb.Option, msg *pb.Option) {
-	newMsg.Code = msg.Code
+func (fm *OptionFieldMask) Mask(msg *pb.Option) *pb.Option {
+	newMsg := &pb.Option{}
+	pb_Option_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *OptionFieldMask) MaskInto(dst *pb.Option, src *pb.Option) {
+	pb_Option_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *OptionFieldMask) MaskSlice(dst []*pb.Option, src []*pb.Option) []*pb.Option {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Option
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Option{}
+		}
+		pb_Option_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *OptionFieldMask) MaskInPlace(msg *pb.Option) {
+	pb_Option_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *OptionFieldMask) Apply(dst *pb.Option, src *pb.Option) {
+	pb_Option_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *OptionFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *OptionFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Option_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *OptionFieldMask) Has(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *OptionFieldMask) HasAny(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *OptionFieldMask) Selection() pb_Option_Selection {
+	return pb_Option_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *OptionFieldMask) Code() bool {
+	return fm.Selection().Code()
+}
+
+// ProviderInfoPaths builds the field paths of pb.ProviderInfo checked by the compiler
+var ProviderInfoPaths = pb_ProviderInfo_Paths{}
+
+// ProductPaths builds the field paths of pb.Product checked by the compiler
+var ProductPaths = pb_Product_Paths{}
+
+// pb_ProviderInfo_Mask is the compiled form of a list of fields of ProviderInfo, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_ProviderInfo_Mask struct {
+	bits [1]uint64
+}
+
+var pb_ProviderInfo_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "name"},
+	{FieldName: "logo"},
+	{FieldName: "imageUrl"},
+}
+
+func pb_ProviderInfo_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_ProviderInfo_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_ProviderInfo_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		case "logo":
+			m.bits[0] |= 1 << 2
+		case "imageUrl", "image_url":
+			m.bits[0] |= 1 << 3
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_ProviderInfo_Keep(m *pb_ProviderInfo_Mask, newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Logo = msg.Logo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.ImageUrl = msg.ImageUrl
+	}
+}
+
+// pb_Product_Mask is the compiled form of a list of fields of Product, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Product_Mask struct {
+	bits       [1]uint64
+	Attributes *pb_Attribute_Mask
+}
+
+var pb_Product_AllFields = []fields.FieldInfo{
+	{FieldName: "sku"},
+	{FieldName: "provider"},
+	{FieldName: "attributes"},
+	{FieldName: "stocks"},
+}
+
+func pb_Product_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Product_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Product_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "sku":
+			m.bits[0] |= 1 << 0
+		case "provider":
+			m.bits[0] |= 1 << 1
+		case "attributes":
+			isSimpleField = false
+			subMask, err := pb_Attribute_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "attributes")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Attributes = subMask
+		case "stocks":
+			m.bits[0] |= 1 << 3
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Product_Keep(m *pb_Product_Mask, newMsg *pb.Product, msg *pb.Product) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Sku = msg.Sku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Provider = msg.Provider
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
+		for _, e := range msg.Attributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Attribute{}
+			pb_Attribute_Keep(m.Attributes, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.Stocks = msg.Stocks
+	}
+}
+
+// pb_Attribute_Mask is the compiled form of a list of fields of Attribute, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Attribute_Mask struct {
+	bits    [1]uint64
+	Options *pb_Option_Mask
+}
+
+var pb_Attribute_AllFields = []fields.FieldInfo{
+	{FieldName: "options"},
+}
+
+func pb_Attribute_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Attribute_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Attribute_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "options":
+			isSimpleField = false
+			subMask, err := pb_Option_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "options")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 0
+			m.Options = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Attribute_Keep(m *pb_Attribute_Mask, newMsg *pb.Attribute, msg *pb.Attribute) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		msgList := make([]*pb.Option, 0, len(msg.Options))
+		for _, e := range msg.Options {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Option{}
+			pb_Option_Keep(m.Options, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Options = msgList
+	}
+}
+
+// pb_Option_Mask is the compiled form of a list of fields of Option, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Option_Mask struct {
+	bits [1]uint64
+}
+
+var pb_Option_AllFields = []fields.FieldInfo{
+	{FieldName: "code"},
+}
+
+func pb_Option_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Option_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Option_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "code":
+			m.bits[0] |= 1 << 0
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Option_Keep(m *pb_Option_Mask, newMsg *pb.Option, msg *pb.Option) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Code = msg.Code
+	}
+}
+
+// pb_ProviderInfo_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_ProviderInfo_AllMask = &pb_ProviderInfo_Mask{bits: [1]uint64{0xf}}
+
+func pb_ProviderInfo_KeepInto(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_ProviderInfo_AllMask
+	}
+
+	srcId := src.Id
+	srcName := src.Name
+	srcLogo := src.Logo
+	srcImageUrl := src.ImageUrl
+
+	*dst = pb.ProviderInfo{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Logo = srcLogo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.ImageUrl = srcImageUrl
+	}
+}
+
+// pb_Product_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Product_AllMask = &pb_Product_Mask{bits: [1]uint64{0xf}}
+
+func pb_Product_KeepInto(m *pb_Product_Mask, dst *pb.Product, src *pb.Product) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Product_AllMask
+	}
+
+	srcSku := src.Sku
+	srcProvider := src.Provider
+	srcAttributes := src.Attributes
+	oldAttributes := dst.Attributes
+	srcStocks := src.Stocks
+
+	*dst = pb.Product{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Sku = srcSku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Provider = srcProvider
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := oldAttributes[:0]
+		for i, e := range srcAttributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Attribute
+			if i < cap(oldAttributes) {
+				subMsg = oldAttributes[:cap(oldAttributes)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Attribute{}
+			}
+			pb_Attribute_KeepInto(m.Attributes, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.Stocks = srcStocks
+	}
+}
+
+// pb_Attribute_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Attribute_AllMask = &pb_Attribute_Mask{bits: [1]uint64{0x1}}
+
+func pb_Attribute_KeepInto(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Attribute_AllMask
+	}
+
+	srcOptions := src.Options
+	oldOptions := dst.Options
+
+	*dst = pb.Attribute{}
+	if m.bits[0]&(1<<0) != 0 {
+		msgList := oldOptions[:0]
+		for i, e := range srcOptions {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Option
+			if i < cap(oldOptions) {
+				subMsg = oldOptions[:cap(oldOptions)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Option{}
+			}
+			pb_Option_KeepInto(m.Options, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Options = msgList
+	}
+}
+
+// pb_Option_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Option_AllMask = &pb_Option_Mask{bits: [1]uint64{0x1}}
+
+func pb_Option_KeepInto(m *pb_Option_Mask, dst *pb.Option, src *pb.Option) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Option_AllMask
+	}
+
+	srcCode := src.Code
+
+	*dst = pb.Option{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Code = srcCode
+	}
+}
+
+// pb_ProviderInfo_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_ProviderInfo_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "imageUrl":
+			field.FieldName = "image_url"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Product_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Product_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "attributes":
+			field.SubFields = pb_Attribute_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Attribute_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Attribute_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "options":
+			field.SubFields = pb_Option_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Option_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Option_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	return fieldInfos
+}
+
+func pb_ProviderInfo_Apply(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_ProviderInfo_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_ProviderInfo_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_ProviderInfo_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+	}
+}
+
+func pb_ProviderInfo_ApplyAll(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	pb_ProviderInfo_Apply_Id(dst, src, opts)
+	pb_ProviderInfo_Apply_Name(dst, src, opts)
+	pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+}
+
+func pb_Product_Apply(m *pb_Product_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Product_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Product_Apply_Sku(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Product_Apply_Provider(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Attributes == nil {
+			pb_Product_Apply_Attributes(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Attributes(m.Attributes, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_Product_Apply_Stocks(dst, src, opts)
+	}
+}
+
+func pb_Product_ApplyAll(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	pb_Product_Apply_Sku(dst, src, opts)
+	pb_Product_Apply_Provider(dst, src, opts)
+	pb_Product_Apply_Attributes(dst, src, opts)
+	pb_Product_Apply_Stocks(dst, src, opts)
+}
+
+func pb_Attribute_Apply(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Attribute_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		if m.Options == nil {
+			pb_Attribute_Apply_Options(dst, src, opts)
+		} else {
+			pb_Attribute_ApplySub_Options(m.Options, dst, src, opts)
+		}
+	}
+}
+
+func pb_Attribute_ApplyAll(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	pb_Attribute_Apply_Options(dst, src, opts)
+}
+
+func pb_Option_Apply(m *pb_Option_Mask, dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Option_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Option_Apply_Code(dst, src, opts)
+	}
+}
+
+func pb_Option_ApplyAll(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	pb_Option_Apply_Code(dst, src, opts)
+}
+
+func pb_ProviderInfo_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_ProviderInfo_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "name":
+			name = "name"
+		case "logo":
+			name = "logo"
+		case "imageUrl", "image_url":
+			name = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["logo"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "logo"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "logo")
+	}
+	if subFields, ok := excluded["imageUrl"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "imageUrl"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "imageUrl")
+	}
+	return result, nil
+}
+
+func pb_Product_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Product_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "sku":
+			name = "sku"
+		case "provider":
+			name = "provider"
+		case "attributes":
+			name = "attributes"
+		case "stocks":
+			name = "stocks"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["sku"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "sku"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "sku")
+	}
+	if subFields, ok := excluded["provider"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "provider"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "provider")
+	}
+	if subFields, ok := excluded["attributes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "attributes"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Attribute_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "attributes")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "attributes", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["stocks"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "stocks"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "stocks")
+	}
+	return result, nil
+}
+
+func pb_Attribute_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Attribute_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "options":
+			name = "options"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 1)
+	if subFields, ok := excluded["options"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "options"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Option_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "options")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "options", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Option_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Option_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "code":
+			name = "code"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 1)
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	return result, nil
+}
+
+func pb_ProviderInfo_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "logo", "logo"):
+			field.FieldName = "logo"
+		case style.Match(field.FieldName, "imageUrl", "image_url"):
+			field.FieldName = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Product_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "sku", "sku"):
+			field.FieldName = "sku"
+		case style.Match(field.FieldName, "provider", "provider"):
+			field.FieldName = "provider"
+		case style.Match(field.FieldName, "attributes", "attributes"):
+			subFields, err := pb_Attribute_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "attributes"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "stocks", "stocks"):
+			field.FieldName = "stocks"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Attribute_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "options", "options"):
+			subFields, err := pb_Option_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "options"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Option_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// =========================================
+// ProviderInfo Apply Functions
+// =========================================
+
+func pb_ProviderInfo_Apply_Id(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_ProviderInfo_Apply_Name(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_ProviderInfo_Apply_Logo(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Logo = src.Logo
+}
+
+func pb_ProviderInfo_Apply_ImageUrl(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.ImageUrl = src.ImageUrl
+}
+
+// =========================================
+// Product Apply Functions
+// =========================================
+
+func pb_Product_Apply_Sku(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.Sku = src.Sku
+}
+
+func pb_Product_Apply_Provider(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.Provider = src.Provider
+}
+
+func pb_Product_Apply_Attributes(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, src.Attributes...)
+		return
+	}
+	dst.Attributes = src.Attributes
+}
+
+func pb_Product_Apply_Stocks(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Stocks = append(dst.Stocks, src.Stocks...)
+		return
+	}
+	dst.Stocks = src.Stocks
+}
+
+func pb_Product_ApplySub_Attributes(m *pb_Attribute_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Attribute, 0, len(src.Attributes))
+	for _, e := range src.Attributes {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Attribute{}
+		pb_Attribute_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, msgList...)
+		return
+	}
+	dst.Attributes = msgList
+}
+
+// =========================================
+// Attribute Apply Functions
+// =========================================
+
+func pb_Attribute_Apply_Options(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, src.Options...)
+		return
+	}
+	dst.Options = src.Options
+}
+
+func pb_Attribute_ApplySub_Options(m *pb_Option_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Option, 0, len(src.Options))
+	for _, e := range src.Options {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Option{}
+		pb_Option_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, msgList...)
+		return
+	}
+	dst.Options = msgList
+}
+
+// =========================================
+// Option Apply Functions
+// =========================================
+
+func pb_Option_Apply_Code(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+// pb_ProviderInfo_Paths builds the field paths of the sub fields of ProviderInfo
+type pb_ProviderInfo_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_ProviderInfo_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_ProviderInfo_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_ProviderInfo_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_ProviderInfo_Paths) Logo() fields.Path {
+	return p.path.Append("logo")
+}
+
+func (p pb_ProviderInfo_Paths) ImageUrl() fields.Path {
+	return p.path.Append("imageUrl")
+}
+
+// pb_Product_Paths builds the field paths of the sub fields of Product
+type pb_Product_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Product_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Product_Paths) Sku() fields.Path {
+	return p.path.Append("sku")
+}
+
+func (p pb_Product_Paths) Provider() fields.Path {
+	return p.path.Append("provider")
+}
+
+func (p pb_Product_Paths) Attributes() pb_Attribute_Paths {
+	return pb_Attribute_Paths{path: p.path.Append("attributes")}
+}
+
+func (p pb_Product_Paths) Stocks() fields.Path {
+	return p.path.Append("stocks")
+}
+
+// pb_Attribute_Paths builds the field paths of the sub fields of Attribute
+type pb_Attribute_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Attribute_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Attribute_Paths) Options() pb_Option_Paths {
+	return pb_Option_Paths{path: p.path.Append("options")}
+}
+
+// pb_Option_Paths builds the field paths of the sub fields of Option
+type pb_Option_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Option_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Option_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+// pb_ProviderInfo_Selection queries whether the fields of ProviderInfo are selected
+type pb_ProviderInfo_Selection struct {
+	selected bool
+	mask     *pb_ProviderInfo_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_ProviderInfo_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_ProviderInfo_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Logo() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) ImageUrl() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func pb_ProviderInfo_HasPath(m *pb_ProviderInfo_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "logo":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<2) != 0)
+	case "imageUrl", "image_url":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Product_Selection queries whether the fields of Product are selected
+type pb_Product_Selection struct {
+	selected bool
+	mask     *pb_Product_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Product_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Product_Selection) Sku() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Product_Selection) Provider() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Product_Selection) Attributes() pb_Attribute_Selection {
+	if s.mask == nil {
+		return pb_Attribute_Selection{selected: s.selected}
+	}
+	return pb_Attribute_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Attributes}
+}
+
+func (s pb_Product_Selection) Stocks() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func pb_Product_HasPath(m *pb_Product_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "sku":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "provider":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "attributes":
+		if m == nil {
+			return len(path) == 1 || pb_Attribute_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Attributes == nil
+		}
+		return pb_Attribute_HasPath(m.Attributes, path[1:], partial)
+	case "stocks":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Attribute_Selection queries whether the fields of Attribute are selected
+type pb_Attribute_Selection struct {
+	selected bool
+	mask     *pb_Attribute_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Attribute_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Attribute_Selection) Options() pb_Option_Selection {
+	if s.mask == nil {
+		return pb_Option_Selection{selected: s.selected}
+	}
+	return pb_Option_Selection{selected: s.mask.bits[0]&(1<<0) != 0, mask: s.mask.Options}
+}
+
+func pb_Attribute_HasPath(m *pb_Attribute_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "options":
+		if m == nil {
+			return len(path) == 1 || pb_Option_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<0) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Options == nil
+		}
+		return pb_Option_HasPath(m.Options, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Option_Selection queries whether the fields of Option are selected
+type pb_Option_Selection struct {
+	selected bool
+	mask     *pb_Option_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Option_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Option_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func pb_Option_HasPath(m *pb_Option_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	default:
+		return false
+	}
 }
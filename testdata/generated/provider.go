@@ -3,336 +3,4146 @@
 package generated
 
 import (
+	"strings"
+
 	"github.com/QuangTung97/fieldmask/fields"
 	pb "github.com/QuangTung97/fieldmask/testdata/pb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type ProviderInfoFieldMask struct {
-	keepFunc     func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo)
+	mask         *pb_ProviderInfo_Mask
+	applyOptions fields.ApplyOptions
 	maskedFields []fields.FieldInfo
 }
 
 func NewProviderInfoFieldMask(maskedFields []string, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	fieldInfos, err := computeProviderInfoFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
+
+func computeProviderInfoFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
 	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
 	if err != nil {
 		return nil, err
 	}
+	return normalizeProviderInfoFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeProviderInfoFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_ProviderInfo_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// ProviderInfoFieldMaskCache caches the field masks of NewProviderInfoFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ProviderInfoFieldMaskCache struct {
+	cache   *fields.Cache[*ProviderInfoFieldMask]
+	options []fields.Option
+}
+
+// NewProviderInfoFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewProviderInfoFieldMaskCache(cache *fields.Cache[*ProviderInfoFieldMask], options ...fields.Option) *ProviderInfoFieldMaskCache {
+	return &ProviderInfoFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewProviderInfoFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ProviderInfoFieldMaskCache) Get(maskedFields []string) (*ProviderInfoFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeProviderInfoFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ProviderInfoFieldMask, error) {
+		return newProviderInfoFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewProviderInfoFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewProviderInfoFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeProviderInfoFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
+
+// NewProviderInfoFieldMaskFromPaths creates a field mask from the paths built by ProviderInfoPaths
+func NewProviderInfoFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	return NewProviderInfoFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewProviderInfoExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewProviderInfoExcludeMask(excludedFields []string, options ...fields.Option) (*ProviderInfoFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeProviderInfoFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_ProviderInfo_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newProviderInfoFieldMask(fieldInfos, options)
+}
 
-	keepFunc, err := pb_ProviderInfo_ComputeKeepFunc(fieldInfos)
+func newProviderInfoFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ProviderInfoFieldMask, error) {
+	mask, err := pb_ProviderInfo_ComputeMask(fieldInfos)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ProviderInfoFieldMask{
-		keepFunc:     keepFunc,
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
 		maskedFields: fieldInfos,
 	}, nil
 }
 
 func (fm *ProviderInfoFieldMask) Mask(msg *pb.ProviderInfo) *pb.ProviderInfo {
 	newMsg := &pb.ProviderInfo{}
-	fm.keepFunc(newMsg, msg)
+	pb_ProviderInfo_Keep(fm.mask, newMsg, msg)
 	return newMsg
 }
 
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProviderInfoFieldMask) MaskInto(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProviderInfoFieldMask) MaskSlice(dst []*pb.ProviderInfo, src []*pb.ProviderInfo) []*pb.ProviderInfo {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.ProviderInfo
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.ProviderInfo{}
+		}
+		pb_ProviderInfo_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProviderInfoFieldMask) MaskInPlace(msg *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProviderInfoFieldMask) Apply(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
 func (fm *ProviderInfoFieldMask) GetMaskedFields() []fields.FieldInfo {
 	return fm.maskedFields
 }
 
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProviderInfoFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_ProviderInfo_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProviderInfoFieldMask) Has(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProviderInfoFieldMask) HasAny(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProviderInfoFieldMask) Selection() pb_ProviderInfo_Selection {
+	return pb_ProviderInfo_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ProviderInfoFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *ProviderInfoFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *ProviderInfoFieldMask) Logo() bool {
+	return fm.Selection().Logo()
+}
+
+func (fm *ProviderInfoFieldMask) ImageUrl() bool {
+	return fm.Selection().ImageUrl()
+}
+
 type ProductFieldMask struct {
-	keepFunc     func(newMsg *pb.Product, msg *pb.Product)
+	mask         *pb_Product_Mask
+	applyOptions fields.ApplyOptions
 	maskedFields []fields.FieldInfo
 }
 
 func NewProductFieldMask(maskedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	fieldInfos, err := computeProductFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
+
+func computeProductFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
 	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
 	if err != nil {
 		return nil, err
 	}
+	return normalizeProductFieldMaskInfos(fieldInfos, options)
+}
 
-	keepFunc, err := pb_Product_ComputeKeepFunc(fieldInfos)
+func normalizeProductFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Product_NormalizeFieldNames(fieldInfos, style)
 	if err != nil {
 		return nil, err
 	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
 
-	return &ProductFieldMask{
-		keepFunc:     keepFunc,
-		maskedFields: fieldInfos,
-	}, nil
+// ProductFieldMaskCache caches the field masks of NewProductFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ProductFieldMaskCache struct {
+	cache   *fields.Cache[*ProductFieldMask]
+	options []fields.Option
 }
 
-func (fm *ProductFieldMask) Mask(msg *pb.Product) *pb.Product {
-	newMsg := &pb.Product{}
-	fm.keepFunc(newMsg, msg)
-	return newMsg
+// NewProductFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewProductFieldMaskCache(cache *fields.Cache[*ProductFieldMask], options ...fields.Option) *ProductFieldMaskCache {
+	return &ProductFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
 }
 
-func (fm *ProductFieldMask) GetMaskedFields() []fields.FieldInfo {
-	return fm.maskedFields
+// Get is the same as NewProductFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ProductFieldMaskCache) Get(maskedFields []string) (*ProductFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeProductFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ProductFieldMask, error) {
+		return newProductFieldMask(fieldInfos, c.options)
+	})
 }
 
-func pb_ProviderInfo_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-			*newMsg = *msg
-		}, nil
+// NewProductFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewProductFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ProductFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
 	}
 
-	var subFuncs []func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo)
+	fieldInfos, err = normalizeProductFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+// NewProductFieldMaskFromPaths creates a field mask from the paths built by ProductPaths
+func NewProductFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ProductFieldMask, error) {
+	return NewProductFieldMask(fields.PathStrings(paths...), options...)
+}
 
-		switch field.FieldName {
-		case "id":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Id)
-		case "name":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Name)
-		case "logo":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_Logo)
-		case "imageUrl":
-			subFuncs = append(subFuncs, pb_ProviderInfo_Keep_ImageUrl)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+// NewProductExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewProductExcludeMask(excludedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
+	excludedInfos, err = normalizeProductFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
 	}
 
-	return func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
-}
+	fieldInfos, err := pb_Product_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
 
-func pb_Product_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Product, msg *pb.Product), error) {
 	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Product, msg *pb.Product) {
-			*newMsg = *msg
-		}, nil
+		return nil, fields.ErrExcludeAllFields
 	}
+	return newProductFieldMask(fieldInfos, options)
+}
 
-	var subFuncs []func(newMsg *pb.Product, msg *pb.Product)
+func newProductFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ProductFieldMask, error) {
+	mask, err := pb_Product_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+	return &ProductFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
 
-		switch field.FieldName {
-		case "sku":
-			subFuncs = append(subFuncs, pb_Product_Keep_Sku)
-		case "provider":
-			isSimpleField = false
-			keepFunc, err := pb_ProviderInfo_ComputeKeepFunc(field.SubFields)
-			if err != nil {
-				return nil, fields.PrependParentField(err, "provider")
-			}
-			subFuncs = append(subFuncs, func(newMsg *pb.Product, msg *pb.Product) {
-				if msg.Provider == nil {
-					return
-				}
-				newSubMsg := &pb.ProviderInfo{}
-				keepFunc(newSubMsg, msg.Provider)
-				newMsg.Provider = newSubMsg
-			})
-		case "attributes":
-			isSimpleField = false
-			keepFunc, err := pb_Attribute_ComputeKeepFunc(field.SubFields)
-			if err != nil {
-				return nil, fields.PrependParentField(err, "attributes")
-			}
-			subFuncs = append(subFuncs, func(newMsg *pb.Product, msg *pb.Product) {
-				msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
-				for _, e := range msg.Attributes {
-					newSubMsg := &pb.Attribute{}
-					keepFunc(newSubMsg, e)
-					msgList = append(msgList, newSubMsg)
-				}
-				newMsg.Attributes = msgList
-			})
-		case "sellerIds":
-			subFuncs = append(subFuncs, pb_Product_Keep_SellerIds)
-		case "brandCodes":
-			subFuncs = append(subFuncs, pb_Product_Keep_BrandCodes)
-		case "createdAt":
-			subFuncs = append(subFuncs, pb_Product_Keep_CreatedAt)
-		case "quantity":
-			subFuncs = append(subFuncs, pb_Product_Keep_Quantity)
-		case "stocks":
-			subFuncs = append(subFuncs, pb_Product_Keep_Stocks)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+func (fm *ProductFieldMask) Mask(msg *pb.Product) *pb.Product {
+	newMsg := &pb.Product{}
+	pb_Product_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProductFieldMask) MaskInto(dst *pb.Product, src *pb.Product) {
+	pb_Product_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProductFieldMask) MaskSlice(dst []*pb.Product, src []*pb.Product) []*pb.Product {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
 		}
+		var newMsg *pb.Product
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Product{}
+		}
+		pb_Product_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
 	}
+	return result
+}
 
-	return func(newMsg *pb.Product, msg *pb.Product) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProductFieldMask) MaskInPlace(msg *pb.Product) {
+	pb_Product_KeepInto(fm.mask, msg, msg)
 }
 
-func pb_Attribute_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Attribute, msg *pb.Attribute), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Attribute, msg *pb.Attribute) {
-			*newMsg = *msg
-		}, nil
-	}
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProductFieldMask) Apply(dst *pb.Product, src *pb.Product) {
+	pb_Product_Apply(fm.mask, dst, src, fm.applyOptions)
+}
 
-	var subFuncs []func(newMsg *pb.Attribute, msg *pb.Attribute)
+func (fm *ProductFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProductFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Product_ToProtoNames(fm.maskedFields))
+}
 
-		switch field.FieldName {
-		case "id":
-			subFuncs = append(subFuncs, pb_Attribute_Keep_Id)
-		case "code":
-			subFuncs = append(subFuncs, pb_Attribute_Keep_Code)
-		case "name":
-			subFuncs = append(subFuncs, pb_Attribute_Keep_Name)
-		case "options":
-			isSimpleField = false
-			keepFunc, err := pb_Option_ComputeKeepFunc(field.SubFields)
-			if err != nil {
-				return nil, fields.PrependParentField(err, "options")
-			}
-			subFuncs = append(subFuncs, func(newMsg *pb.Attribute, msg *pb.Attribute) {
-				msgList := make([]*pb.Option, 0, len(msg.Options))
-				for _, e := range msg.Options {
-					newSubMsg := &pb.Option{}
-					keepFunc(newSubMsg, e)
-					msgList = append(msgList, newSubMsg)
-				}
-				newMsg.Options = msgList
-			})
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProductFieldMask) Has(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), false)
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
-	}
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProductFieldMask) HasAny(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), true)
+}
 
-	return func(newMsg *pb.Attribute, msg *pb.Attribute) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProductFieldMask) Selection() pb_Product_Selection {
+	return pb_Product_Selection{selected: true, mask: fm.mask}
 }
 
-func pb_Option_ComputeKeepFunc(fieldInfos []fields.FieldInfo) (func(newMsg *pb.Option, msg *pb.Option), error) {
-	if len(fieldInfos) == 0 {
-		return func(newMsg *pb.Option, msg *pb.Option) {
-			*newMsg = *msg
-		}, nil
-	}
+func (fm *ProductFieldMask) Sku() bool {
+	return fm.Selection().Sku()
+}
 
-	var subFuncs []func(newMsg *pb.Option, msg *pb.Option)
+func (fm *ProductFieldMask) Provider() pb_ProviderInfo_Selection {
+	return fm.Selection().Provider()
+}
 
-	for _, field := range fieldInfos {
-		isSimpleField := true
+func (fm *ProductFieldMask) Attributes() pb_Attribute_Selection {
+	return fm.Selection().Attributes()
+}
 
-		switch field.FieldName {
-		case "code":
-			subFuncs = append(subFuncs, pb_Option_Keep_Code)
-		case "name":
-			subFuncs = append(subFuncs, pb_Option_Keep_Name)
-		default:
-			return nil, fields.ErrFieldNotFound(field.FieldName)
-		}
+func (fm *ProductFieldMask) SellerIds() bool {
+	return fm.Selection().SellerIds()
+}
 
-		if isSimpleField && len(field.SubFields) > 0 {
-			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
-		}
-	}
+func (fm *ProductFieldMask) BrandCodes() bool {
+	return fm.Selection().BrandCodes()
+}
 
-	return func(newMsg *pb.Option, msg *pb.Option) {
-		for _, fn := range subFuncs {
-			fn(newMsg, msg)
-		}
-	}, nil
+func (fm *ProductFieldMask) CreatedAt() bool {
+	return fm.Selection().CreatedAt()
 }
 
-// =========================================
-// ProviderInfo Keep Functions
-// =========================================
+func (fm *ProductFieldMask) Quantity() bool {
+	return fm.Selection().Quantity()
+}
+
+func (fm *ProductFieldMask) Stocks() bool {
+	return fm.Selection().Stocks()
+}
 
-func pb_ProviderInfo_Keep_Id(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Id = msg.Id
+// ProviderMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) ProviderMask() *ProviderInfoFieldMask {
+	if fm.mask == nil {
+		return &ProviderInfoFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<1) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "provider")
+	return &ProviderInfoFieldMask{
+		mask:         fm.mask.Provider,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
 }
 
-func pb_ProviderInfo_Keep_Name(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Name = msg.Name
+// AttributesMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) AttributesMask() *AttributeFieldMask {
+	if fm.mask == nil {
+		return &AttributeFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "attributes")
+	return &AttributeFieldMask{
+		mask:         fm.mask.Attributes,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
 }
 
-func pb_ProviderInfo_Keep_Logo(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.Logo = msg.Logo
+type AttributeFieldMask struct {
+	mask         *pb_Attribute_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
 }
 
-func pb_ProviderInfo_Keep_ImageUrl(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
-	newMsg.ImageUrl = msg.ImageUrl
+func (fm *AttributeFieldMask) Mask(msg *pb.Attribute) *pb.Attribute {
+	newMsg := &pb.Attribute{}
+	pb_Attribute_Keep(fm.mask, newMsg, msg)
+	return newMsg
 }
 
-// =========================================
-// Product Keep Functions
-// =========================================
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *AttributeFieldMask) MaskInto(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, dst, src)
+}
 
-func pb_Product_Keep_Sku(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Sku = msg.Sku
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *AttributeFieldMask) MaskSlice(dst []*pb.Attribute, src []*pb.Attribute) []*pb.Attribute {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Attribute
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Attribute{}
+		}
+		pb_Attribute_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
 }
 
-func pb_Product_Keep_SellerIds(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.SellerIds = msg.SellerIds
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *AttributeFieldMask) MaskInPlace(msg *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, msg, msg)
 }
 
-func pb_Product_Keep_BrandCodes(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.BrandCodes = msg.BrandCodes
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *AttributeFieldMask) Apply(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_Apply(fm.mask, dst, src, fm.applyOptions)
 }
 
-func pb_Product_Keep_CreatedAt(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.CreatedAt = msg.CreatedAt
+func (fm *AttributeFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
 }
 
-func pb_Product_Keep_Quantity(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Quantity = msg.Quantity
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *AttributeFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Attribute_ToProtoNames(fm.maskedFields))
 }
 
-func pb_Product_Keep_Stocks(newMsg *pb.Product, msg *pb.Product) {
-	newMsg.Stocks = msg.Stocks
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *AttributeFieldMask) Has(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), false)
 }
 
-// =========================================
-// Attribute Keep Functions
-// =========================================
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *AttributeFieldMask) HasAny(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), true)
+}
 
-func pb_Attribute_Keep_Id(newMsg *pb.Attribute, msg *pb.Attribute) {
-	newMsg.Id = msg.Id
+// Selection returns the type-safe queries of the selected fields
+func (fm *AttributeFieldMask) Selection() pb_Attribute_Selection {
+	return pb_Attribute_Selection{selected: true, mask: fm.mask}
 }
 
-func pb_Attribute_Keep_Code(newMsg *pb.Attribute, msg *pb.Attribute) {
-	newMsg.Code = msg.Code
+func (fm *AttributeFieldMask) Id() bool {
+	return fm.Selection().Id()
 }
 
-func pb_Attribute_Keep_Name(newMsg *pb.Attribute, msg *pb.Attribute) {
-	newMsg.Name = msg.Name
+func (fm *AttributeFieldMask) Code() bool {
+	return fm.Selection().Code()
 }
 
-// =========================================
-// Option Keep Functions
-// =========================================
+func (fm *AttributeFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *AttributeFieldMask) Options() pb_Option_Selection {
+	return fm.Selection().Options()
+}
 
-func pb_Option_Keep_Code(newMsg *pb.Option, msg *pb.Option) {
-	newMsg.Code = msg.Code
+// OptionsMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *AttributeFieldMask) OptionsMask() *OptionFieldMask {
+	if fm.mask == nil {
+		return &OptionFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<3) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "options")
+	return &OptionFieldMask{
+		mask:         fm.mask.Options,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
 }
 
-func pb_Option_Keep_Name(newMsg *pb.Option, msg *pb.Option) {
-	newMsg.Name = msg.Name
+type OptionFieldMask struct {
+	mask         *pb_Option_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *OptionFieldMask) Mask(msg *pb.Option) *pb.Option {
+	newMsg := &pb.Option{}
+	pb_Option_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *OptionFieldMask) MaskInto(dst *pb.Option, src *pb.Option) {
+	pb_Option_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *OptionFieldMask) MaskSlice(dst []*pb.Option, src []*pb.Option) []*pb.Option {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Option
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Option{}
+		}
+		pb_Option_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *OptionFieldMask) MaskInPlace(msg *pb.Option) {
+	pb_Option_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *OptionFieldMask) Apply(dst *pb.Option, src *pb.Option) {
+	pb_Option_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *OptionFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *OptionFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Option_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *OptionFieldMask) Has(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *OptionFieldMask) HasAny(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *OptionFieldMask) Selection() pb_Option_Selection {
+	return pb_Option_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *OptionFieldMask) Code() bool {
+	return fm.Selection().Code()
+}
+
+func (fm *OptionFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+type ItemFieldMask struct {
+	mask         *pb_Item_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewItemFieldMask(maskedFields []string, options ...fields.Option) (*ItemFieldMask, error) {
+	fieldInfos, err := computeItemFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newItemFieldMask(fieldInfos, options)
+}
+
+func computeItemFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeItemFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeItemFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Item_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// ItemFieldMaskCache caches the field masks of NewItemFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ItemFieldMaskCache struct {
+	cache   *fields.Cache[*ItemFieldMask]
+	options []fields.Option
+}
+
+// NewItemFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewItemFieldMaskCache(cache *fields.Cache[*ItemFieldMask], options ...fields.Option) *ItemFieldMaskCache {
+	return &ItemFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewItemFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ItemFieldMaskCache) Get(maskedFields []string) (*ItemFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeItemFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ItemFieldMask, error) {
+		return newItemFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewItemFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewItemFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ItemFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeItemFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newItemFieldMask(fieldInfos, options)
+}
+
+// NewItemFieldMaskFromPaths creates a field mask from the paths built by ItemPaths
+func NewItemFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ItemFieldMask, error) {
+	return NewItemFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewItemExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewItemExcludeMask(excludedFields []string, options ...fields.Option) (*ItemFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeItemFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Item_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newItemFieldMask(fieldInfos, options)
+}
+
+func newItemFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ItemFieldMask, error) {
+	mask, err := pb_Item_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ItemFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *ItemFieldMask) Mask(msg *pb.Item) *pb.Item {
+	newMsg := &pb.Item{}
+	pb_Item_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ItemFieldMask) MaskInto(dst *pb.Item, src *pb.Item) {
+	pb_Item_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ItemFieldMask) MaskSlice(dst []*pb.Item, src []*pb.Item) []*pb.Item {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Item
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Item{}
+		}
+		pb_Item_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ItemFieldMask) MaskInPlace(msg *pb.Item) {
+	pb_Item_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ItemFieldMask) Apply(dst *pb.Item, src *pb.Item) {
+	pb_Item_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *ItemFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ItemFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Item_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ItemFieldMask) Has(path string) bool {
+	return pb_Item_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ItemFieldMask) HasAny(path string) bool {
+	return pb_Item_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ItemFieldMask) Selection() pb_Item_Selection {
+	return pb_Item_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ItemFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *ItemFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *ItemFieldMask) Book() pb_Book_Selection {
+	return fm.Selection().Book()
+}
+
+func (fm *ItemFieldMask) ReleasedAt() bool {
+	return fm.Selection().ReleasedAt()
+}
+
+func (fm *ItemFieldMask) Quantity() bool {
+	return fm.Selection().Quantity()
+}
+
+// BookMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ItemFieldMask) BookMask() *BookFieldMask {
+	if fm.mask == nil {
+		return &BookFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "book")
+	return &BookFieldMask{
+		mask:         fm.mask.Book,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type BookFieldMask struct {
+	mask         *pb_Book_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *BookFieldMask) Mask(msg *pb.Book) *pb.Book {
+	newMsg := &pb.Book{}
+	pb_Book_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *BookFieldMask) MaskInto(dst *pb.Book, src *pb.Book) {
+	pb_Book_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *BookFieldMask) MaskSlice(dst []*pb.Book, src []*pb.Book) []*pb.Book {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Book
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Book{}
+		}
+		pb_Book_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *BookFieldMask) MaskInPlace(msg *pb.Book) {
+	pb_Book_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *BookFieldMask) Apply(dst *pb.Book, src *pb.Book) {
+	pb_Book_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *BookFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *BookFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Book_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *BookFieldMask) Has(path string) bool {
+	return pb_Book_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *BookFieldMask) HasAny(path string) bool {
+	return pb_Book_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *BookFieldMask) Selection() pb_Book_Selection {
+	return pb_Book_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *BookFieldMask) Isbn() bool {
+	return fm.Selection().Isbn()
+}
+
+func (fm *BookFieldMask) Title() bool {
+	return fm.Selection().Title()
+}
+
+func (fm *BookFieldMask) Publisher() pb_ProviderInfo_Selection {
+	return fm.Selection().Publisher()
+}
+
+// PublisherMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *BookFieldMask) PublisherMask() *ProviderInfoFieldMask {
+	if fm.mask == nil {
+		return &ProviderInfoFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "publisher")
+	return &ProviderInfoFieldMask{
+		mask:         fm.mask.Publisher,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type CatalogFieldMask struct {
+	mask         *pb_Catalog_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewCatalogFieldMask(maskedFields []string, options ...fields.Option) (*CatalogFieldMask, error) {
+	fieldInfos, err := computeCatalogFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newCatalogFieldMask(fieldInfos, options)
+}
+
+func computeCatalogFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeCatalogFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeCatalogFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Catalog_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// CatalogFieldMaskCache caches the field masks of NewCatalogFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type CatalogFieldMaskCache struct {
+	cache   *fields.Cache[*CatalogFieldMask]
+	options []fields.Option
+}
+
+// NewCatalogFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewCatalogFieldMaskCache(cache *fields.Cache[*CatalogFieldMask], options ...fields.Option) *CatalogFieldMaskCache {
+	return &CatalogFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewCatalogFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *CatalogFieldMaskCache) Get(maskedFields []string) (*CatalogFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeCatalogFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*CatalogFieldMask, error) {
+		return newCatalogFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewCatalogFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewCatalogFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*CatalogFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeCatalogFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newCatalogFieldMask(fieldInfos, options)
+}
+
+// NewCatalogFieldMaskFromPaths creates a field mask from the paths built by CatalogPaths
+func NewCatalogFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*CatalogFieldMask, error) {
+	return NewCatalogFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewCatalogExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewCatalogExcludeMask(excludedFields []string, options ...fields.Option) (*CatalogFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeCatalogFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Catalog_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newCatalogFieldMask(fieldInfos, options)
+}
+
+func newCatalogFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*CatalogFieldMask, error) {
+	mask, err := pb_Catalog_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CatalogFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *CatalogFieldMask) Mask(msg *pb.Catalog) *pb.Catalog {
+	newMsg := &pb.Catalog{}
+	pb_Catalog_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *CatalogFieldMask) MaskInto(dst *pb.Catalog, src *pb.Catalog) {
+	pb_Catalog_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *CatalogFieldMask) MaskSlice(dst []*pb.Catalog, src []*pb.Catalog) []*pb.Catalog {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Catalog
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Catalog{}
+		}
+		pb_Catalog_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *CatalogFieldMask) MaskInPlace(msg *pb.Catalog) {
+	pb_Catalog_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *CatalogFieldMask) Apply(dst *pb.Catalog, src *pb.Catalog) {
+	pb_Catalog_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *CatalogFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *CatalogFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Catalog_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *CatalogFieldMask) Has(path string) bool {
+	return pb_Catalog_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *CatalogFieldMask) HasAny(path string) bool {
+	return pb_Catalog_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *CatalogFieldMask) Selection() pb_Catalog_Selection {
+	return pb_Catalog_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *CatalogFieldMask) Code() bool {
+	return fm.Selection().Code()
+}
+
+func (fm *CatalogFieldMask) AttributesByCode() pb_Attribute_Selection {
+	return fm.Selection().AttributesByCode()
+}
+
+func (fm *CatalogFieldMask) Providers() pb_ProviderInfo_Selection {
+	return fm.Selection().Providers()
+}
+
+func (fm *CatalogFieldMask) Labels() bool {
+	return fm.Selection().Labels()
+}
+
+func (fm *CatalogFieldMask) UpdatedTimes() bool {
+	return fm.Selection().UpdatedTimes()
+}
+
+// AttributesByCodeMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *CatalogFieldMask) AttributesByCodeMask() *AttributeFieldMask {
+	if fm.mask == nil {
+		return &AttributeFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<1) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "attributesByCode")
+	return &AttributeFieldMask{
+		mask:         fm.mask.AttributesByCode,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// ProvidersMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *CatalogFieldMask) ProvidersMask() *ProviderInfoFieldMask {
+	if fm.mask == nil {
+		return &ProviderInfoFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "providers")
+	return &ProviderInfoFieldMask{
+		mask:         fm.mask.Providers,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// ProviderInfoPaths builds the field paths of pb.ProviderInfo checked by the compiler
+var ProviderInfoPaths = pb_ProviderInfo_Paths{}
+
+// ProductPaths builds the field paths of pb.Product checked by the compiler
+var ProductPaths = pb_Product_Paths{}
+
+// ItemPaths builds the field paths of pb.Item checked by the compiler
+var ItemPaths = pb_Item_Paths{}
+
+// CatalogPaths builds the field paths of pb.Catalog checked by the compiler
+var CatalogPaths = pb_Catalog_Paths{}
+
+// pb_ProviderInfo_Mask is the compiled form of a list of fields of ProviderInfo, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_ProviderInfo_Mask struct {
+	bits [1]uint64
+}
+
+var pb_ProviderInfo_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "name"},
+	{FieldName: "logo"},
+	{FieldName: "imageUrl"},
+}
+
+func pb_ProviderInfo_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_ProviderInfo_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_ProviderInfo_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		case "logo":
+			m.bits[0] |= 1 << 2
+		case "imageUrl", "image_url":
+			m.bits[0] |= 1 << 3
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_ProviderInfo_Keep(m *pb_ProviderInfo_Mask, newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Logo = msg.Logo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.ImageUrl = msg.ImageUrl
+	}
+}
+
+// pb_Product_Mask is the compiled form of a list of fields of Product, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Product_Mask struct {
+	bits       [1]uint64
+	Provider   *pb_ProviderInfo_Mask
+	Attributes *pb_Attribute_Mask
+}
+
+var pb_Product_AllFields = []fields.FieldInfo{
+	{FieldName: "sku"},
+	{FieldName: "provider"},
+	{FieldName: "attributes"},
+	{FieldName: "sellerIds"},
+	{FieldName: "brandCodes"},
+	{FieldName: "createdAt"},
+	{FieldName: "quantity"},
+	{FieldName: "stocks"},
+}
+
+func pb_Product_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Product_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Product_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "sku":
+			m.bits[0] |= 1 << 0
+		case "provider":
+			isSimpleField = false
+			subMask, err := pb_ProviderInfo_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "provider")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 1
+			m.Provider = subMask
+		case "attributes":
+			isSimpleField = false
+			subMask, err := pb_Attribute_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "attributes")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Attributes = subMask
+		case "sellerIds", "seller_ids":
+			m.bits[0] |= 1 << 3
+		case "brandCodes", "brand_codes":
+			m.bits[0] |= 1 << 4
+		case "createdAt", "created_at":
+			m.bits[0] |= 1 << 5
+		case "quantity":
+			m.bits[0] |= 1 << 6
+		case "stocks":
+			m.bits[0] |= 1 << 7
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Product_Keep(m *pb_Product_Mask, newMsg *pb.Product, msg *pb.Product) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Sku = msg.Sku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if msg.Provider != nil {
+			newMsg.Provider = &pb.ProviderInfo{}
+			pb_ProviderInfo_Keep(m.Provider, newMsg.Provider, msg.Provider)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
+		for _, e := range msg.Attributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Attribute{}
+			pb_Attribute_Keep(m.Attributes, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.SellerIds = msg.SellerIds
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		newMsg.BrandCodes = msg.BrandCodes
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		newMsg.CreatedAt = msg.CreatedAt
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		newMsg.Quantity = msg.Quantity
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		newMsg.Stocks = msg.Stocks
+	}
+}
+
+// pb_Attribute_Mask is the compiled form of a list of fields of Attribute, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Attribute_Mask struct {
+	bits    [1]uint64
+	Options *pb_Option_Mask
+}
+
+var pb_Attribute_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "code"},
+	{FieldName: "name"},
+	{FieldName: "options"},
+}
+
+func pb_Attribute_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Attribute_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Attribute_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "code":
+			m.bits[0] |= 1 << 1
+		case "name":
+			m.bits[0] |= 1 << 2
+		case "options":
+			isSimpleField = false
+			subMask, err := pb_Option_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "options")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 3
+			m.Options = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Attribute_Keep(m *pb_Attribute_Mask, newMsg *pb.Attribute, msg *pb.Attribute) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Code = msg.Code
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := make([]*pb.Option, 0, len(msg.Options))
+		for _, e := range msg.Options {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Option{}
+			pb_Option_Keep(m.Options, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Options = msgList
+	}
+}
+
+// pb_Option_Mask is the compiled form of a list of fields of Option, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Option_Mask struct {
+	bits [1]uint64
+}
+
+var pb_Option_AllFields = []fields.FieldInfo{
+	{FieldName: "code"},
+	{FieldName: "name"},
+}
+
+func pb_Option_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Option_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Option_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "code":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Option_Keep(m *pb_Option_Mask, newMsg *pb.Option, msg *pb.Option) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Code = msg.Code
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+}
+
+// pb_Item_Mask is the compiled form of a list of fields of Item, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Item_Mask struct {
+	bits [1]uint64
+	Book *pb_Book_Mask
+}
+
+var pb_Item_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "name"},
+	{FieldName: "book"},
+	{FieldName: "releasedAt"},
+	{FieldName: "quantity"},
+}
+
+func pb_Item_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Item_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Item_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Item_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		case "book":
+			isSimpleField = false
+			subMask, err := pb_Book_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "book")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Book = subMask
+		case "releasedAt", "released_at":
+			m.bits[0] |= 1 << 3
+		case "quantity":
+			m.bits[0] |= 1 << 4
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Item_Keep(m *pb_Item_Mask, newMsg *pb.Item, msg *pb.Item) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if wrapper, ok := msg.Payload.(*pb.Item_Name); ok {
+			newMsg.Payload = wrapper
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if wrapper, ok := msg.Payload.(*pb.Item_Book); ok && wrapper.Book != nil {
+			newSubMsg := &pb.Book{}
+			pb_Book_Keep(m.Book, newSubMsg, wrapper.Book)
+			newMsg.Payload = &pb.Item_Book{Book: newSubMsg}
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if wrapper, ok := msg.Payload.(*pb.Item_ReleasedAt); ok {
+			newMsg.Payload = wrapper
+		}
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		newMsg.Quantity = msg.Quantity
+	}
+}
+
+// pb_Book_Mask is the compiled form of a list of fields of Book, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Book_Mask struct {
+	bits      [1]uint64
+	Publisher *pb_ProviderInfo_Mask
+}
+
+var pb_Book_AllFields = []fields.FieldInfo{
+	{FieldName: "isbn"},
+	{FieldName: "title"},
+	{FieldName: "publisher"},
+}
+
+func pb_Book_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Book_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Book_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Book_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "isbn":
+			m.bits[0] |= 1 << 0
+		case "title":
+			m.bits[0] |= 1 << 1
+		case "publisher":
+			isSimpleField = false
+			subMask, err := pb_ProviderInfo_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "publisher")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Publisher = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Book_Keep(m *pb_Book_Mask, newMsg *pb.Book, msg *pb.Book) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Isbn = msg.Isbn
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Title = msg.Title
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if msg.Publisher != nil {
+			newMsg.Publisher = &pb.ProviderInfo{}
+			pb_ProviderInfo_Keep(m.Publisher, newMsg.Publisher, msg.Publisher)
+		}
+	}
+}
+
+// pb_Catalog_Mask is the compiled form of a list of fields of Catalog, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Catalog_Mask struct {
+	bits             [1]uint64
+	AttributesByCode *pb_Attribute_Mask
+	Providers        *pb_ProviderInfo_Mask
+}
+
+var pb_Catalog_AllFields = []fields.FieldInfo{
+	{FieldName: "code"},
+	{FieldName: "attributesByCode"},
+	{FieldName: "providers"},
+	{FieldName: "labels"},
+	{FieldName: "updatedTimes"},
+}
+
+func pb_Catalog_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Catalog_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Catalog_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Catalog_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "code":
+			m.bits[0] |= 1 << 0
+		case "attributesByCode", "attributes_by_code":
+			isSimpleField = false
+			subMask, err := pb_Attribute_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "attributesByCode")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 1
+			m.AttributesByCode = subMask
+		case "providers":
+			isSimpleField = false
+			subMask, err := pb_ProviderInfo_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "providers")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Providers = subMask
+		case "labels":
+			m.bits[0] |= 1 << 3
+		case "updatedTimes", "updated_times":
+			m.bits[0] |= 1 << 4
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Catalog_Keep(m *pb_Catalog_Mask, newMsg *pb.Catalog, msg *pb.Catalog) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Code = msg.Code
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		msgMap := make(map[string]*pb.Attribute, len(msg.AttributesByCode))
+		for k, e := range msg.AttributesByCode {
+			if e == nil {
+				msgMap[k] = nil
+				continue
+			}
+			newSubMsg := &pb.Attribute{}
+			pb_Attribute_Keep(m.AttributesByCode, newSubMsg, e)
+			msgMap[k] = newSubMsg
+		}
+		newMsg.AttributesByCode = msgMap
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgMap := make(map[int32]*pb.ProviderInfo, len(msg.Providers))
+		for k, e := range msg.Providers {
+			if e == nil {
+				msgMap[k] = nil
+				continue
+			}
+			newSubMsg := &pb.ProviderInfo{}
+			pb_ProviderInfo_Keep(m.Providers, newSubMsg, e)
+			msgMap[k] = newSubMsg
+		}
+		newMsg.Providers = msgMap
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.Labels = msg.Labels
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		newMsg.UpdatedTimes = msg.UpdatedTimes
+	}
+}
+
+// pb_ProviderInfo_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_ProviderInfo_AllMask = &pb_ProviderInfo_Mask{bits: [1]uint64{0xf}}
+
+func pb_ProviderInfo_KeepInto(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_ProviderInfo_AllMask
+	}
+
+	srcId := src.Id
+	srcName := src.Name
+	srcLogo := src.Logo
+	srcImageUrl := src.ImageUrl
+
+	*dst = pb.ProviderInfo{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Logo = srcLogo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.ImageUrl = srcImageUrl
+	}
+}
+
+// pb_Product_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Product_AllMask = &pb_Product_Mask{bits: [1]uint64{0xff}}
+
+func pb_Product_KeepInto(m *pb_Product_Mask, dst *pb.Product, src *pb.Product) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Product_AllMask
+	}
+
+	srcSku := src.Sku
+	srcProvider := src.Provider
+	oldProvider := dst.Provider
+	srcAttributes := src.Attributes
+	oldAttributes := dst.Attributes
+	srcSellerIds := src.SellerIds
+	srcBrandCodes := src.BrandCodes
+	srcCreatedAt := src.CreatedAt
+	srcQuantity := src.Quantity
+	srcStocks := src.Stocks
+
+	*dst = pb.Product{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Sku = srcSku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if srcProvider != nil {
+			subMsg := oldProvider
+			if subMsg == nil {
+				subMsg = &pb.ProviderInfo{}
+			}
+			pb_ProviderInfo_KeepInto(m.Provider, subMsg, srcProvider)
+			dst.Provider = subMsg
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := oldAttributes[:0]
+		for i, e := range srcAttributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Attribute
+			if i < cap(oldAttributes) {
+				subMsg = oldAttributes[:cap(oldAttributes)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Attribute{}
+			}
+			pb_Attribute_KeepInto(m.Attributes, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.SellerIds = srcSellerIds
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		dst.BrandCodes = srcBrandCodes
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		dst.CreatedAt = srcCreatedAt
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		dst.Quantity = srcQuantity
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		dst.Stocks = srcStocks
+	}
+}
+
+// pb_Attribute_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Attribute_AllMask = &pb_Attribute_Mask{bits: [1]uint64{0xf}}
+
+func pb_Attribute_KeepInto(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Attribute_AllMask
+	}
+
+	srcId := src.Id
+	srcCode := src.Code
+	srcName := src.Name
+	srcOptions := src.Options
+	oldOptions := dst.Options
+
+	*dst = pb.Attribute{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Code = srcCode
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := oldOptions[:0]
+		for i, e := range srcOptions {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Option
+			if i < cap(oldOptions) {
+				subMsg = oldOptions[:cap(oldOptions)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Option{}
+			}
+			pb_Option_KeepInto(m.Options, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Options = msgList
+	}
+}
+
+// pb_Option_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Option_AllMask = &pb_Option_Mask{bits: [1]uint64{0x3}}
+
+func pb_Option_KeepInto(m *pb_Option_Mask, dst *pb.Option, src *pb.Option) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Option_AllMask
+	}
+
+	srcCode := src.Code
+	srcName := src.Name
+
+	*dst = pb.Option{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Code = srcCode
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+}
+
+// pb_Item_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Item_AllMask = &pb_Item_Mask{bits: [1]uint64{0x1f}}
+
+func pb_Item_KeepInto(m *pb_Item_Mask, dst *pb.Item, src *pb.Item) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Item_AllMask
+	}
+
+	srcId := src.Id
+	srcPayload := src.Payload
+	oldPayload := dst.Payload
+	srcQuantity := src.Quantity
+
+	*dst = pb.Item{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if wrapper, ok := srcPayload.(*pb.Item_Name); ok {
+			dst.Payload = wrapper
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if wrapper, ok := srcPayload.(*pb.Item_Book); ok && wrapper.Book != nil {
+			dstWrapper, ok := oldPayload.(*pb.Item_Book)
+			if !ok || dstWrapper.Book == nil {
+				dstWrapper = &pb.Item_Book{Book: &pb.Book{}}
+			}
+			pb_Book_KeepInto(m.Book, dstWrapper.Book, wrapper.Book)
+			dst.Payload = dstWrapper
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if wrapper, ok := srcPayload.(*pb.Item_ReleasedAt); ok {
+			dst.Payload = wrapper
+		}
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		dst.Quantity = srcQuantity
+	}
+}
+
+// pb_Book_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Book_AllMask = &pb_Book_Mask{bits: [1]uint64{0x7}}
+
+func pb_Book_KeepInto(m *pb_Book_Mask, dst *pb.Book, src *pb.Book) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Book_AllMask
+	}
+
+	srcIsbn := src.Isbn
+	srcTitle := src.Title
+	srcPublisher := src.Publisher
+	oldPublisher := dst.Publisher
+
+	*dst = pb.Book{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Isbn = srcIsbn
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Title = srcTitle
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if srcPublisher != nil {
+			subMsg := oldPublisher
+			if subMsg == nil {
+				subMsg = &pb.ProviderInfo{}
+			}
+			pb_ProviderInfo_KeepInto(m.Publisher, subMsg, srcPublisher)
+			dst.Publisher = subMsg
+		}
+	}
+}
+
+// pb_Catalog_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Catalog_AllMask = &pb_Catalog_Mask{bits: [1]uint64{0x1f}}
+
+func pb_Catalog_KeepInto(m *pb_Catalog_Mask, dst *pb.Catalog, src *pb.Catalog) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Catalog_AllMask
+	}
+
+	srcCode := src.Code
+	srcAttributesByCode := src.AttributesByCode
+	oldAttributesByCode := dst.AttributesByCode
+	srcProviders := src.Providers
+	oldProviders := dst.Providers
+	srcLabels := src.Labels
+	srcUpdatedTimes := src.UpdatedTimes
+
+	*dst = pb.Catalog{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Code = srcCode
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		msgMap := oldAttributesByCode
+		if msgMap == nil {
+			msgMap = make(map[string]*pb.Attribute, len(srcAttributesByCode))
+		}
+		for k := range msgMap {
+			if _, ok := srcAttributesByCode[k]; !ok {
+				delete(msgMap, k)
+			}
+		}
+		for k, e := range srcAttributesByCode {
+			if e == nil {
+				msgMap[k] = nil
+				continue
+			}
+			subMsg := msgMap[k]
+			if subMsg == nil {
+				subMsg = &pb.Attribute{}
+			}
+			pb_Attribute_KeepInto(m.AttributesByCode, subMsg, e)
+			msgMap[k] = subMsg
+		}
+		dst.AttributesByCode = msgMap
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgMap := oldProviders
+		if msgMap == nil {
+			msgMap = make(map[int32]*pb.ProviderInfo, len(srcProviders))
+		}
+		for k := range msgMap {
+			if _, ok := srcProviders[k]; !ok {
+				delete(msgMap, k)
+			}
+		}
+		for k, e := range srcProviders {
+			if e == nil {
+				msgMap[k] = nil
+				continue
+			}
+			subMsg := msgMap[k]
+			if subMsg == nil {
+				subMsg = &pb.ProviderInfo{}
+			}
+			pb_ProviderInfo_KeepInto(m.Providers, subMsg, e)
+			msgMap[k] = subMsg
+		}
+		dst.Providers = msgMap
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.Labels = srcLabels
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		dst.UpdatedTimes = srcUpdatedTimes
+	}
+}
+
+// pb_ProviderInfo_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_ProviderInfo_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "imageUrl":
+			field.FieldName = "image_url"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Product_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Product_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "provider":
+			field.SubFields = pb_ProviderInfo_ToProtoNames(field.SubFields)
+		case "attributes":
+			field.SubFields = pb_Attribute_ToProtoNames(field.SubFields)
+		case "sellerIds":
+			field.FieldName = "seller_ids"
+		case "brandCodes":
+			field.FieldName = "brand_codes"
+		case "createdAt":
+			field.FieldName = "created_at"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Attribute_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Attribute_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "options":
+			field.SubFields = pb_Option_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Option_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Option_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	return fieldInfos
+}
+
+// pb_Item_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Item_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Item_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "book":
+			field.SubFields = pb_Book_ToProtoNames(field.SubFields)
+		case "releasedAt":
+			field.FieldName = "released_at"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Book_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Book_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Book_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "publisher":
+			field.SubFields = pb_ProviderInfo_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Catalog_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Catalog_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Catalog_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "attributesByCode":
+			field.FieldName = "attributes_by_code"
+			field.SubFields = pb_Attribute_ToProtoNames(field.SubFields)
+		case "providers":
+			field.SubFields = pb_ProviderInfo_ToProtoNames(field.SubFields)
+		case "updatedTimes":
+			field.FieldName = "updated_times"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+func pb_ProviderInfo_Apply(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_ProviderInfo_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_ProviderInfo_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_ProviderInfo_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+	}
+}
+
+func pb_ProviderInfo_ApplyAll(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	pb_ProviderInfo_Apply_Id(dst, src, opts)
+	pb_ProviderInfo_Apply_Name(dst, src, opts)
+	pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+}
+
+func pb_Product_Apply(m *pb_Product_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Product_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Product_Apply_Sku(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if m.Provider == nil {
+			pb_Product_Apply_Provider(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Provider(m.Provider, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Attributes == nil {
+			pb_Product_Apply_Attributes(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Attributes(m.Attributes, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_Product_Apply_SellerIds(dst, src, opts)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		pb_Product_Apply_BrandCodes(dst, src, opts)
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		pb_Product_Apply_CreatedAt(dst, src, opts)
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		pb_Product_Apply_Quantity(dst, src, opts)
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		pb_Product_Apply_Stocks(dst, src, opts)
+	}
+}
+
+func pb_Product_ApplyAll(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	pb_Product_Apply_Sku(dst, src, opts)
+	pb_Product_Apply_Provider(dst, src, opts)
+	pb_Product_Apply_Attributes(dst, src, opts)
+	pb_Product_Apply_SellerIds(dst, src, opts)
+	pb_Product_Apply_BrandCodes(dst, src, opts)
+	pb_Product_Apply_CreatedAt(dst, src, opts)
+	pb_Product_Apply_Quantity(dst, src, opts)
+	pb_Product_Apply_Stocks(dst, src, opts)
+}
+
+func pb_Attribute_Apply(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Attribute_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Attribute_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Attribute_Apply_Code(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		pb_Attribute_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if m.Options == nil {
+			pb_Attribute_Apply_Options(dst, src, opts)
+		} else {
+			pb_Attribute_ApplySub_Options(m.Options, dst, src, opts)
+		}
+	}
+}
+
+func pb_Attribute_ApplyAll(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	pb_Attribute_Apply_Id(dst, src, opts)
+	pb_Attribute_Apply_Code(dst, src, opts)
+	pb_Attribute_Apply_Name(dst, src, opts)
+	pb_Attribute_Apply_Options(dst, src, opts)
+}
+
+func pb_Option_Apply(m *pb_Option_Mask, dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Option_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Option_Apply_Code(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Option_Apply_Name(dst, src, opts)
+	}
+}
+
+func pb_Option_ApplyAll(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	pb_Option_Apply_Code(dst, src, opts)
+	pb_Option_Apply_Name(dst, src, opts)
+}
+
+func pb_Item_Apply(m *pb_Item_Mask, dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Item_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Item_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Item_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Book == nil {
+			pb_Item_Apply_Book(dst, src, opts)
+		} else {
+			pb_Item_ApplySub_Book(m.Book, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_Item_Apply_ReleasedAt(dst, src, opts)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		pb_Item_Apply_Quantity(dst, src, opts)
+	}
+}
+
+func pb_Item_ApplyAll(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	pb_Item_Apply_Id(dst, src, opts)
+	pb_Item_Apply_Name(dst, src, opts)
+	pb_Item_Apply_Book(dst, src, opts)
+	pb_Item_Apply_ReleasedAt(dst, src, opts)
+	pb_Item_Apply_Quantity(dst, src, opts)
+}
+
+func pb_Book_Apply(m *pb_Book_Mask, dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Book_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Book_Apply_Isbn(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Book_Apply_Title(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Publisher == nil {
+			pb_Book_Apply_Publisher(dst, src, opts)
+		} else {
+			pb_Book_ApplySub_Publisher(m.Publisher, dst, src, opts)
+		}
+	}
+}
+
+func pb_Book_ApplyAll(dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	pb_Book_Apply_Isbn(dst, src, opts)
+	pb_Book_Apply_Title(dst, src, opts)
+	pb_Book_Apply_Publisher(dst, src, opts)
+}
+
+func pb_Catalog_Apply(m *pb_Catalog_Mask, dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Catalog_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Catalog_Apply_Code(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if m.AttributesByCode == nil {
+			pb_Catalog_Apply_AttributesByCode(dst, src, opts)
+		} else {
+			pb_Catalog_ApplySub_AttributesByCode(m.AttributesByCode, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Providers == nil {
+			pb_Catalog_Apply_Providers(dst, src, opts)
+		} else {
+			pb_Catalog_ApplySub_Providers(m.Providers, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_Catalog_Apply_Labels(dst, src, opts)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		pb_Catalog_Apply_UpdatedTimes(dst, src, opts)
+	}
+}
+
+func pb_Catalog_ApplyAll(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	pb_Catalog_Apply_Code(dst, src, opts)
+	pb_Catalog_Apply_AttributesByCode(dst, src, opts)
+	pb_Catalog_Apply_Providers(dst, src, opts)
+	pb_Catalog_Apply_Labels(dst, src, opts)
+	pb_Catalog_Apply_UpdatedTimes(dst, src, opts)
+}
+
+func pb_ProviderInfo_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_ProviderInfo_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "name":
+			name = "name"
+		case "logo":
+			name = "logo"
+		case "imageUrl", "image_url":
+			name = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["logo"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "logo"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "logo")
+	}
+	if subFields, ok := excluded["imageUrl"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "imageUrl"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "imageUrl")
+	}
+	return result, nil
+}
+
+func pb_Product_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Product_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "sku":
+			name = "sku"
+		case "provider":
+			name = "provider"
+		case "attributes":
+			name = "attributes"
+		case "sellerIds", "seller_ids":
+			name = "sellerIds"
+		case "brandCodes", "brand_codes":
+			name = "brandCodes"
+		case "createdAt", "created_at":
+			name = "createdAt"
+		case "quantity":
+			name = "quantity"
+		case "stocks":
+			name = "stocks"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 8)
+	if subFields, ok := excluded["sku"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "sku"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "sku")
+	}
+	if subFields, ok := excluded["provider"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "provider"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_ProviderInfo_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "provider")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "provider", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["attributes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "attributes"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Attribute_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "attributes")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "attributes", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["sellerIds"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "sellerIds"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "sellerIds")
+	}
+	if subFields, ok := excluded["brandCodes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "brandCodes"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "brandCodes")
+	}
+	if subFields, ok := excluded["createdAt"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "createdAt"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "createdAt")
+	}
+	if subFields, ok := excluded["quantity"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "quantity"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "quantity")
+	}
+	if subFields, ok := excluded["stocks"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "stocks"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "stocks")
+	}
+	return result, nil
+}
+
+func pb_Attribute_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Attribute_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "code":
+			name = "code"
+		case "name":
+			name = "name"
+		case "options":
+			name = "options"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["options"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "options"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Option_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "options")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "options", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Option_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Option_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "code":
+			name = "code"
+		case "name":
+			name = "name"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 2)
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	return result, nil
+}
+
+func pb_Item_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Item_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "name":
+			name = "name"
+		case "book":
+			name = "book"
+		case "releasedAt", "released_at":
+			name = "releasedAt"
+		case "quantity":
+			name = "quantity"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 5)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["book"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "book"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Book_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "book")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "book", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["releasedAt"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "releasedAt"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "releasedAt")
+	}
+	if subFields, ok := excluded["quantity"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "quantity"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "quantity")
+	}
+	return result, nil
+}
+
+func pb_Book_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Book_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "isbn":
+			name = "isbn"
+		case "title":
+			name = "title"
+		case "publisher":
+			name = "publisher"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 3)
+	if subFields, ok := excluded["isbn"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "isbn"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "isbn")
+	}
+	if subFields, ok := excluded["title"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "title"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "title")
+	}
+	if subFields, ok := excluded["publisher"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "publisher"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_ProviderInfo_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "publisher")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "publisher", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Catalog_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Catalog_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "code":
+			name = "code"
+		case "attributesByCode", "attributes_by_code":
+			name = "attributesByCode"
+		case "providers":
+			name = "providers"
+		case "labels":
+			name = "labels"
+		case "updatedTimes", "updated_times":
+			name = "updatedTimes"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 5)
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	if subFields, ok := excluded["attributesByCode"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "attributesByCode"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Attribute_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "attributesByCode")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "attributesByCode", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["providers"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "providers"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_ProviderInfo_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "providers")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "providers", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["labels"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "labels"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "labels")
+	}
+	if subFields, ok := excluded["updatedTimes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "updatedTimes"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "updatedTimes")
+	}
+	return result, nil
+}
+
+func pb_ProviderInfo_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "logo", "logo"):
+			field.FieldName = "logo"
+		case style.Match(field.FieldName, "imageUrl", "image_url"):
+			field.FieldName = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Product_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "sku", "sku"):
+			field.FieldName = "sku"
+		case style.Match(field.FieldName, "provider", "provider"):
+			subFields, err := pb_ProviderInfo_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "provider"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "attributes", "attributes"):
+			subFields, err := pb_Attribute_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "attributes"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "sellerIds", "seller_ids"):
+			field.FieldName = "sellerIds"
+		case style.Match(field.FieldName, "brandCodes", "brand_codes"):
+			field.FieldName = "brandCodes"
+		case style.Match(field.FieldName, "createdAt", "created_at"):
+			field.FieldName = "createdAt"
+		case style.Match(field.FieldName, "quantity", "quantity"):
+			field.FieldName = "quantity"
+		case style.Match(field.FieldName, "stocks", "stocks"):
+			field.FieldName = "stocks"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Attribute_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "options", "options"):
+			subFields, err := pb_Option_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "options"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Option_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Item_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "book", "book"):
+			subFields, err := pb_Book_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "book"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "releasedAt", "released_at"):
+			field.FieldName = "releasedAt"
+		case style.Match(field.FieldName, "quantity", "quantity"):
+			field.FieldName = "quantity"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Book_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "isbn", "isbn"):
+			field.FieldName = "isbn"
+		case style.Match(field.FieldName, "title", "title"):
+			field.FieldName = "title"
+		case style.Match(field.FieldName, "publisher", "publisher"):
+			subFields, err := pb_ProviderInfo_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "publisher"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Catalog_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		case style.Match(field.FieldName, "attributesByCode", "attributes_by_code"):
+			subFields, err := pb_Attribute_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "attributesByCode"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "providers", "providers"):
+			subFields, err := pb_ProviderInfo_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "providers"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "labels", "labels"):
+			field.FieldName = "labels"
+		case style.Match(field.FieldName, "updatedTimes", "updated_times"):
+			field.FieldName = "updatedTimes"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// =========================================
+// ProviderInfo Apply Functions
+// =========================================
+
+func pb_ProviderInfo_Apply_Id(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_ProviderInfo_Apply_Name(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_ProviderInfo_Apply_Logo(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Logo = src.Logo
+}
+
+func pb_ProviderInfo_Apply_ImageUrl(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.ImageUrl = src.ImageUrl
+}
+
+// =========================================
+// Product Apply Functions
+// =========================================
+
+func pb_Product_Apply_Sku(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.Sku = src.Sku
+}
+
+func pb_Product_Apply_Provider(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Provider == nil {
+		dst.Provider = src.Provider
+		return
+	}
+	if dst.Provider == nil {
+		dst.Provider = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_ApplyAll(dst.Provider, src.Provider, opts)
+}
+
+func pb_Product_Apply_Attributes(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, src.Attributes...)
+		return
+	}
+	dst.Attributes = src.Attributes
+}
+
+func pb_Product_Apply_SellerIds(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.SellerIds = append(dst.SellerIds, src.SellerIds...)
+		return
+	}
+	dst.SellerIds = src.SellerIds
+}
+
+func pb_Product_Apply_BrandCodes(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.BrandCodes = append(dst.BrandCodes, src.BrandCodes...)
+		return
+	}
+	dst.BrandCodes = src.BrandCodes
+}
+
+func pb_Product_Apply_CreatedAt(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.CreatedAt = src.CreatedAt
+}
+
+func pb_Product_Apply_Quantity(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.Quantity = src.Quantity
+}
+
+func pb_Product_Apply_Stocks(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Stocks = append(dst.Stocks, src.Stocks...)
+		return
+	}
+	dst.Stocks = src.Stocks
+}
+
+func pb_Product_ApplySub_Provider(m *pb_ProviderInfo_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if dst.Provider == nil && src.Provider == nil {
+		return
+	}
+	if dst.Provider == nil {
+		dst.Provider = &pb.ProviderInfo{}
+	}
+	srcSubMsg := src.Provider
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_Apply(m, dst.Provider, srcSubMsg, opts)
+}
+
+func pb_Product_ApplySub_Attributes(m *pb_Attribute_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Attribute, 0, len(src.Attributes))
+	for _, e := range src.Attributes {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Attribute{}
+		pb_Attribute_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, msgList...)
+		return
+	}
+	dst.Attributes = msgList
+}
+
+// =========================================
+// Attribute Apply Functions
+// =========================================
+
+func pb_Attribute_Apply_Id(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_Attribute_Apply_Code(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+func pb_Attribute_Apply_Name(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_Attribute_Apply_Options(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, src.Options...)
+		return
+	}
+	dst.Options = src.Options
+}
+
+func pb_Attribute_ApplySub_Options(m *pb_Option_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Option, 0, len(src.Options))
+	for _, e := range src.Options {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Option{}
+		pb_Option_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, msgList...)
+		return
+	}
+	dst.Options = msgList
+}
+
+// =========================================
+// Option Apply Functions
+// =========================================
+
+func pb_Option_Apply_Code(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+func pb_Option_Apply_Name(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+// =========================================
+// Item Apply Functions
+// =========================================
+
+func pb_Item_Apply_Id(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_Item_Apply_Name(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	wrapper, ok := src.Payload.(*pb.Item_Name)
+	if !ok {
+		if _, ok := dst.Payload.(*pb.Item_Name); ok {
+			dst.Payload = nil
+		}
+		return
+	}
+	dst.Payload = &pb.Item_Name{Name: wrapper.Name}
+}
+
+func pb_Item_Apply_Book(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	wrapper, ok := src.Payload.(*pb.Item_Book)
+	if !ok {
+		if _, ok := dst.Payload.(*pb.Item_Book); ok {
+			dst.Payload = nil
+		}
+		return
+	}
+	if !opts.MergeMessages || wrapper.Book == nil {
+		dst.Payload = &pb.Item_Book{Book: wrapper.Book}
+		return
+	}
+	dstWrapper, ok := dst.Payload.(*pb.Item_Book)
+	if !ok || dstWrapper.Book == nil {
+		dstWrapper = &pb.Item_Book{Book: &pb.Book{}}
+		dst.Payload = dstWrapper
+	}
+	pb_Book_ApplyAll(dstWrapper.Book, wrapper.Book, opts)
+}
+
+func pb_Item_Apply_ReleasedAt(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	wrapper, ok := src.Payload.(*pb.Item_ReleasedAt)
+	if !ok {
+		if _, ok := dst.Payload.(*pb.Item_ReleasedAt); ok {
+			dst.Payload = nil
+		}
+		return
+	}
+	dst.Payload = &pb.Item_ReleasedAt{ReleasedAt: wrapper.ReleasedAt}
+}
+
+func pb_Item_Apply_Quantity(dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	dst.Quantity = src.Quantity
+}
+
+func pb_Item_ApplySub_Book(m *pb_Book_Mask, dst *pb.Item, src *pb.Item, opts fields.ApplyOptions) {
+	wrapper, ok := src.Payload.(*pb.Item_Book)
+	if !ok {
+		if _, ok := dst.Payload.(*pb.Item_Book); ok {
+			dst.Payload = nil
+		}
+		return
+	}
+	dstWrapper, ok := dst.Payload.(*pb.Item_Book)
+	if !ok || dstWrapper.Book == nil {
+		dstWrapper = &pb.Item_Book{Book: &pb.Book{}}
+		dst.Payload = dstWrapper
+	}
+	srcSubMsg := wrapper.Book
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.Book{}
+	}
+	pb_Book_Apply(m, dstWrapper.Book, srcSubMsg, opts)
+}
+
+// =========================================
+// Book Apply Functions
+// =========================================
+
+func pb_Book_Apply_Isbn(dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	dst.Isbn = src.Isbn
+}
+
+func pb_Book_Apply_Title(dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	dst.Title = src.Title
+}
+
+func pb_Book_Apply_Publisher(dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Publisher == nil {
+		dst.Publisher = src.Publisher
+		return
+	}
+	if dst.Publisher == nil {
+		dst.Publisher = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_ApplyAll(dst.Publisher, src.Publisher, opts)
+}
+
+func pb_Book_ApplySub_Publisher(m *pb_ProviderInfo_Mask, dst *pb.Book, src *pb.Book, opts fields.ApplyOptions) {
+	if dst.Publisher == nil && src.Publisher == nil {
+		return
+	}
+	if dst.Publisher == nil {
+		dst.Publisher = &pb.ProviderInfo{}
+	}
+	srcSubMsg := src.Publisher
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_Apply(m, dst.Publisher, srcSubMsg, opts)
+}
+
+// =========================================
+// Catalog Apply Functions
+// =========================================
+
+func pb_Catalog_Apply_Code(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+func pb_Catalog_Apply_AttributesByCode(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.AttributesByCode = fields.MergeMap(dst.AttributesByCode, src.AttributesByCode)
+		return
+	}
+	dst.AttributesByCode = src.AttributesByCode
+}
+
+func pb_Catalog_Apply_Providers(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Providers = fields.MergeMap(dst.Providers, src.Providers)
+		return
+	}
+	dst.Providers = src.Providers
+}
+
+func pb_Catalog_Apply_Labels(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Labels = fields.MergeMap(dst.Labels, src.Labels)
+		return
+	}
+	dst.Labels = src.Labels
+}
+
+func pb_Catalog_Apply_UpdatedTimes(dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.UpdatedTimes = fields.MergeMap(dst.UpdatedTimes, src.UpdatedTimes)
+		return
+	}
+	dst.UpdatedTimes = src.UpdatedTimes
+}
+
+func pb_Catalog_ApplySub_AttributesByCode(m *pb_Attribute_Mask, dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	msgMap := make(map[string]*pb.Attribute, len(src.AttributesByCode))
+	for k, e := range src.AttributesByCode {
+		if e == nil {
+			msgMap[k] = nil
+			continue
+		}
+		newSubMsg := &pb.Attribute{}
+		pb_Attribute_Keep(m, newSubMsg, e)
+		msgMap[k] = newSubMsg
+	}
+	if opts.AppendRepeated {
+		dst.AttributesByCode = fields.MergeMap(dst.AttributesByCode, msgMap)
+		return
+	}
+	dst.AttributesByCode = msgMap
+}
+
+func pb_Catalog_ApplySub_Providers(m *pb_ProviderInfo_Mask, dst *pb.Catalog, src *pb.Catalog, opts fields.ApplyOptions) {
+	msgMap := make(map[int32]*pb.ProviderInfo, len(src.Providers))
+	for k, e := range src.Providers {
+		if e == nil {
+			msgMap[k] = nil
+			continue
+		}
+		newSubMsg := &pb.ProviderInfo{}
+		pb_ProviderInfo_Keep(m, newSubMsg, e)
+		msgMap[k] = newSubMsg
+	}
+	if opts.AppendRepeated {
+		dst.Providers = fields.MergeMap(dst.Providers, msgMap)
+		return
+	}
+	dst.Providers = msgMap
+}
+
+// pb_ProviderInfo_Paths builds the field paths of the sub fields of ProviderInfo
+type pb_ProviderInfo_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_ProviderInfo_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_ProviderInfo_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_ProviderInfo_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_ProviderInfo_Paths) Logo() fields.Path {
+	return p.path.Append("logo")
+}
+
+func (p pb_ProviderInfo_Paths) ImageUrl() fields.Path {
+	return p.path.Append("imageUrl")
+}
+
+// pb_Product_Paths builds the field paths of the sub fields of Product
+type pb_Product_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Product_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Product_Paths) Sku() fields.Path {
+	return p.path.Append("sku")
+}
+
+func (p pb_Product_Paths) Provider() pb_ProviderInfo_Paths {
+	return pb_ProviderInfo_Paths{path: p.path.Append("provider")}
+}
+
+func (p pb_Product_Paths) Attributes() pb_Attribute_Paths {
+	return pb_Attribute_Paths{path: p.path.Append("attributes")}
+}
+
+func (p pb_Product_Paths) SellerIds() fields.Path {
+	return p.path.Append("sellerIds")
+}
+
+func (p pb_Product_Paths) BrandCodes() fields.Path {
+	return p.path.Append("brandCodes")
+}
+
+func (p pb_Product_Paths) CreatedAt() fields.Path {
+	return p.path.Append("createdAt")
+}
+
+func (p pb_Product_Paths) Quantity() fields.Path {
+	return p.path.Append("quantity")
+}
+
+func (p pb_Product_Paths) Stocks() fields.Path {
+	return p.path.Append("stocks")
+}
+
+// pb_Attribute_Paths builds the field paths of the sub fields of Attribute
+type pb_Attribute_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Attribute_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Attribute_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_Attribute_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+func (p pb_Attribute_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_Attribute_Paths) Options() pb_Option_Paths {
+	return pb_Option_Paths{path: p.path.Append("options")}
+}
+
+// pb_Option_Paths builds the field paths of the sub fields of Option
+type pb_Option_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Option_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Option_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+func (p pb_Option_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+// pb_Item_Paths builds the field paths of the sub fields of Item
+type pb_Item_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Item_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Item_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_Item_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_Item_Paths) Book() pb_Book_Paths {
+	return pb_Book_Paths{path: p.path.Append("book")}
+}
+
+func (p pb_Item_Paths) ReleasedAt() fields.Path {
+	return p.path.Append("releasedAt")
+}
+
+func (p pb_Item_Paths) Quantity() fields.Path {
+	return p.path.Append("quantity")
+}
+
+// pb_Book_Paths builds the field paths of the sub fields of Book
+type pb_Book_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Book_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Book_Paths) Isbn() fields.Path {
+	return p.path.Append("isbn")
+}
+
+func (p pb_Book_Paths) Title() fields.Path {
+	return p.path.Append("title")
+}
+
+func (p pb_Book_Paths) Publisher() pb_ProviderInfo_Paths {
+	return pb_ProviderInfo_Paths{path: p.path.Append("publisher")}
+}
+
+// pb_Catalog_Paths builds the field paths of the sub fields of Catalog
+type pb_Catalog_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Catalog_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Catalog_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+func (p pb_Catalog_Paths) AttributesByCode() pb_Attribute_Paths {
+	return pb_Attribute_Paths{path: p.path.Append("attributesByCode")}
+}
+
+func (p pb_Catalog_Paths) Providers() pb_ProviderInfo_Paths {
+	return pb_ProviderInfo_Paths{path: p.path.Append("providers")}
+}
+
+func (p pb_Catalog_Paths) Labels() fields.Path {
+	return p.path.Append("labels")
+}
+
+func (p pb_Catalog_Paths) UpdatedTimes() fields.Path {
+	return p.path.Append("updatedTimes")
+}
+
+// pb_ProviderInfo_Selection queries whether the fields of ProviderInfo are selected
+type pb_ProviderInfo_Selection struct {
+	selected bool
+	mask     *pb_ProviderInfo_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_ProviderInfo_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_ProviderInfo_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Logo() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) ImageUrl() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func pb_ProviderInfo_HasPath(m *pb_ProviderInfo_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "logo":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<2) != 0)
+	case "imageUrl", "image_url":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Product_Selection queries whether the fields of Product are selected
+type pb_Product_Selection struct {
+	selected bool
+	mask     *pb_Product_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Product_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Product_Selection) Sku() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Product_Selection) Provider() pb_ProviderInfo_Selection {
+	if s.mask == nil {
+		return pb_ProviderInfo_Selection{selected: s.selected}
+	}
+	return pb_ProviderInfo_Selection{selected: s.mask.bits[0]&(1<<1) != 0, mask: s.mask.Provider}
+}
+
+func (s pb_Product_Selection) Attributes() pb_Attribute_Selection {
+	if s.mask == nil {
+		return pb_Attribute_Selection{selected: s.selected}
+	}
+	return pb_Attribute_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Attributes}
+}
+
+func (s pb_Product_Selection) SellerIds() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func (s pb_Product_Selection) BrandCodes() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<4) != 0)
+}
+
+func (s pb_Product_Selection) CreatedAt() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<5) != 0)
+}
+
+func (s pb_Product_Selection) Quantity() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<6) != 0)
+}
+
+func (s pb_Product_Selection) Stocks() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<7) != 0)
+}
+
+func pb_Product_HasPath(m *pb_Product_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "sku":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "provider":
+		if m == nil {
+			return len(path) == 1 || pb_ProviderInfo_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<1) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Provider == nil
+		}
+		return pb_ProviderInfo_HasPath(m.Provider, path[1:], partial)
+	case "attributes":
+		if m == nil {
+			return len(path) == 1 || pb_Attribute_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Attributes == nil
+		}
+		return pb_Attribute_HasPath(m.Attributes, path[1:], partial)
+	case "sellerIds", "seller_ids":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	case "brandCodes", "brand_codes":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<4) != 0)
+	case "createdAt", "created_at":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<5) != 0)
+	case "quantity":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<6) != 0)
+	case "stocks":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<7) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Attribute_Selection queries whether the fields of Attribute are selected
+type pb_Attribute_Selection struct {
+	selected bool
+	mask     *pb_Attribute_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Attribute_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Attribute_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Attribute_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Attribute_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb_Attribute_Selection) Options() pb_Option_Selection {
+	if s.mask == nil {
+		return pb_Option_Selection{selected: s.selected}
+	}
+	return pb_Option_Selection{selected: s.mask.bits[0]&(1<<3) != 0, mask: s.mask.Options}
+}
+
+func pb_Attribute_HasPath(m *pb_Attribute_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<2) != 0)
+	case "options":
+		if m == nil {
+			return len(path) == 1 || pb_Option_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<3) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Options == nil
+		}
+		return pb_Option_HasPath(m.Options, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Option_Selection queries whether the fields of Option are selected
+type pb_Option_Selection struct {
+	selected bool
+	mask     *pb_Option_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Option_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Option_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Option_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func pb_Option_HasPath(m *pb_Option_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Item_Selection queries whether the fields of Item are selected
+type pb_Item_Selection struct {
+	selected bool
+	mask     *pb_Item_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Item_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Item_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Item_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Item_Selection) Book() pb_Book_Selection {
+	if s.mask == nil {
+		return pb_Book_Selection{selected: s.selected}
+	}
+	return pb_Book_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Book}
+}
+
+func (s pb_Item_Selection) ReleasedAt() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func (s pb_Item_Selection) Quantity() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<4) != 0)
+}
+
+func pb_Item_HasPath(m *pb_Item_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "book":
+		if m == nil {
+			return len(path) == 1 || pb_Book_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Book == nil
+		}
+		return pb_Book_HasPath(m.Book, path[1:], partial)
+	case "releasedAt", "released_at":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	case "quantity":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<4) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Book_Selection queries whether the fields of Book are selected
+type pb_Book_Selection struct {
+	selected bool
+	mask     *pb_Book_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Book_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Book_Selection) Isbn() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Book_Selection) Title() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Book_Selection) Publisher() pb_ProviderInfo_Selection {
+	if s.mask == nil {
+		return pb_ProviderInfo_Selection{selected: s.selected}
+	}
+	return pb_ProviderInfo_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Publisher}
+}
+
+func pb_Book_HasPath(m *pb_Book_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "isbn":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "title":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "publisher":
+		if m == nil {
+			return len(path) == 1 || pb_ProviderInfo_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Publisher == nil
+		}
+		return pb_ProviderInfo_HasPath(m.Publisher, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Catalog_Selection queries whether the fields of Catalog are selected
+type pb_Catalog_Selection struct {
+	selected bool
+	mask     *pb_Catalog_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Catalog_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Catalog_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Catalog_Selection) AttributesByCode() pb_Attribute_Selection {
+	if s.mask == nil {
+		return pb_Attribute_Selection{selected: s.selected}
+	}
+	return pb_Attribute_Selection{selected: s.mask.bits[0]&(1<<1) != 0, mask: s.mask.AttributesByCode}
+}
+
+func (s pb_Catalog_Selection) Providers() pb_ProviderInfo_Selection {
+	if s.mask == nil {
+		return pb_ProviderInfo_Selection{selected: s.selected}
+	}
+	return pb_ProviderInfo_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Providers}
+}
+
+func (s pb_Catalog_Selection) Labels() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func (s pb_Catalog_Selection) UpdatedTimes() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<4) != 0)
+}
+
+func pb_Catalog_HasPath(m *pb_Catalog_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "attributesByCode", "attributes_by_code":
+		if m == nil {
+			return len(path) == 1 || pb_Attribute_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<1) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.AttributesByCode == nil
+		}
+		return pb_Attribute_HasPath(m.AttributesByCode, path[1:], partial)
+	case "providers":
+		if m == nil {
+			return len(path) == 1 || pb_ProviderInfo_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Providers == nil
+		}
+		return pb_ProviderInfo_HasPath(m.Providers, path[1:], partial)
+	case "labels":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	case "updatedTimes", "updated_times":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<4) != 0)
+	default:
+		return false
+	}
 }
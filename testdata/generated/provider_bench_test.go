@@ -0,0 +1,382 @@
+package generated
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+func newProductForBenchmark() *pb.Product {
+	attributes := make([]*pb.Attribute, 0, 10)
+	for i := 0; i < 10; i++ {
+		attributes = append(attributes, &pb.Attribute{
+			Id:   int32(i),
+			Code: "ATTR",
+			Name: "Attribute Name",
+			Options: []*pb.Option{
+				{Code: "OPTION01", Name: "Option Name 01"},
+				{Code: "OPTION02", Name: "Option Name 02"},
+			},
+		})
+	}
+
+	return &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			Logo:     "Provider Logo",
+			ImageUrl: "provider-image-url",
+		},
+		Attributes: attributes,
+		SellerIds:  []int32{51, 52},
+		BrandCodes: []string{"BRAND01", "BRAND02"},
+		CreatedAt:  types.TimestampNow(),
+		Quantity:   &types.DoubleValue{Value: 886},
+	}
+}
+
+var benchmarkMaskedFields = []string{
+	"sku", "provider.{id|name}", "attributes.{id|code|options.code}", "sellerIds", "createdAt",
+}
+
+func BenchmarkNewProductFieldMask(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, err := NewProductFieldMask(benchmarkMaskedFields)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// BenchmarkProductFieldMaskCache_Get compares with BenchmarkNewProductFieldMask, the raw masked fields are cached
+func BenchmarkProductFieldMaskCache_Get(b *testing.B) {
+	cache := NewProductFieldMaskCache(fields.NewCache[*ProductFieldMask](16))
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, err := cache.Get(benchmarkMaskedFields)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func BenchmarkProductFieldMask_Mask(b *testing.B) {
+	fm, err := NewProductFieldMask(benchmarkMaskedFields)
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		fm.Mask(product)
+	}
+}
+
+func BenchmarkProductFieldMask_Mask_Simple_Fields(b *testing.B) {
+	fm, err := NewProductFieldMask([]string{"sku", "sellerIds", "brandCodes", "quantity"})
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		fm.Mask(product)
+	}
+}
+
+func BenchmarkProductFieldMask_Apply(b *testing.B) {
+	fm, err := NewProductFieldMask(benchmarkMaskedFields)
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		dst := &pb.Product{}
+		fm.Apply(dst, product)
+	}
+}
+
+func BenchmarkProductFieldMask_MaskInto(b *testing.B) {
+	fm, err := NewProductFieldMask(benchmarkMaskedFields)
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+	dst := &pb.Product{}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		fm.MaskInto(dst, product)
+	}
+}
+
+// =========================================
+// Baseline: Closure Masks
+// =========================================
+
+// baselineKeepFunc is the form of the field masks generated before the per-type bitset masks,
+// compiled to a slice of closures for each message. The BenchmarkBaseline_ benchmarks are the baseline
+// of the benchmarks of the same names without the prefix
+type baselineKeepFunc[T any] func(newMsg *T, msg *T)
+
+func joinBaselineKeepFuncs[T any](subFuncs []baselineKeepFunc[T]) baselineKeepFunc[T] {
+	return func(newMsg *T, msg *T) {
+		for _, fn := range subFuncs {
+			fn(newMsg, msg)
+		}
+	}
+}
+
+func checkBaselineSimpleField(field fields.FieldInfo) error {
+	if len(field.SubFields) > 0 {
+		return fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+	}
+	return nil
+}
+
+func computeBaselineProductKeepFunc(fieldInfos []fields.FieldInfo) (baselineKeepFunc[pb.Product], error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+	if len(fieldInfos) == 0 {
+		return func(newMsg *pb.Product, msg *pb.Product) {
+			*newMsg = *msg
+		}, nil
+	}
+
+	var subFuncs []baselineKeepFunc[pb.Product]
+	for _, field := range fieldInfos {
+		var fn baselineKeepFunc[pb.Product]
+		switch field.FieldName {
+		case "sku":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.Sku = msg.Sku }
+		case "provider":
+			keepFunc, err := computeBaselineProviderInfoKeepFunc(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "provider")
+			}
+			subFuncs = append(subFuncs, func(newMsg *pb.Product, msg *pb.Product) {
+				if msg.Provider == nil {
+					return
+				}
+				newSubMsg := &pb.ProviderInfo{}
+				keepFunc(newSubMsg, msg.Provider)
+				newMsg.Provider = newSubMsg
+			})
+			continue
+		case "attributes":
+			keepFunc, err := computeBaselineAttributeKeepFunc(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "attributes")
+			}
+			subFuncs = append(subFuncs, func(newMsg *pb.Product, msg *pb.Product) {
+				msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
+				for _, e := range msg.Attributes {
+					newSubMsg := &pb.Attribute{}
+					keepFunc(newSubMsg, e)
+					msgList = append(msgList, newSubMsg)
+				}
+				newMsg.Attributes = msgList
+			})
+			continue
+		case "sellerIds":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.SellerIds = msg.SellerIds }
+		case "brandCodes":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.BrandCodes = msg.BrandCodes }
+		case "createdAt":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.CreatedAt = msg.CreatedAt }
+		case "quantity":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.Quantity = msg.Quantity }
+		case "stocks":
+			fn = func(newMsg *pb.Product, msg *pb.Product) { newMsg.Stocks = msg.Stocks }
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if err := checkBaselineSimpleField(field); err != nil {
+			return nil, err
+		}
+		subFuncs = append(subFuncs, fn)
+	}
+	return joinBaselineKeepFuncs(subFuncs), nil
+}
+
+func computeBaselineProviderInfoKeepFunc(fieldInfos []fields.FieldInfo) (baselineKeepFunc[pb.ProviderInfo], error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+	if len(fieldInfos) == 0 {
+		return func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
+			*newMsg = *msg
+		}, nil
+	}
+
+	var subFuncs []baselineKeepFunc[pb.ProviderInfo]
+	for _, field := range fieldInfos {
+		var fn baselineKeepFunc[pb.ProviderInfo]
+		switch field.FieldName {
+		case "id":
+			fn = func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) { newMsg.Id = msg.Id }
+		case "name":
+			fn = func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) { newMsg.Name = msg.Name }
+		case "logo":
+			fn = func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) { newMsg.Logo = msg.Logo }
+		case "imageUrl":
+			fn = func(newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) { newMsg.ImageUrl = msg.ImageUrl }
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if err := checkBaselineSimpleField(field); err != nil {
+			return nil, err
+		}
+		subFuncs = append(subFuncs, fn)
+	}
+	return joinBaselineKeepFuncs(subFuncs), nil
+}
+
+func computeBaselineAttributeKeepFunc(fieldInfos []fields.FieldInfo) (baselineKeepFunc[pb.Attribute], error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+	if len(fieldInfos) == 0 {
+		return func(newMsg *pb.Attribute, msg *pb.Attribute) {
+			*newMsg = *msg
+		}, nil
+	}
+
+	var subFuncs []baselineKeepFunc[pb.Attribute]
+	for _, field := range fieldInfos {
+		var fn baselineKeepFunc[pb.Attribute]
+		switch field.FieldName {
+		case "id":
+			fn = func(newMsg *pb.Attribute, msg *pb.Attribute) { newMsg.Id = msg.Id }
+		case "code":
+			fn = func(newMsg *pb.Attribute, msg *pb.Attribute) { newMsg.Code = msg.Code }
+		case "name":
+			fn = func(newMsg *pb.Attribute, msg *pb.Attribute) { newMsg.Name = msg.Name }
+		case "options":
+			keepFunc, err := computeBaselineOptionKeepFunc(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "options")
+			}
+			subFuncs = append(subFuncs, func(newMsg *pb.Attribute, msg *pb.Attribute) {
+				msgList := make([]*pb.Option, 0, len(msg.Options))
+				for _, e := range msg.Options {
+					newSubMsg := &pb.Option{}
+					keepFunc(newSubMsg, e)
+					msgList = append(msgList, newSubMsg)
+				}
+				newMsg.Options = msgList
+			})
+			continue
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if err := checkBaselineSimpleField(field); err != nil {
+			return nil, err
+		}
+		subFuncs = append(subFuncs, fn)
+	}
+	return joinBaselineKeepFuncs(subFuncs), nil
+}
+
+func computeBaselineOptionKeepFunc(fieldInfos []fields.FieldInfo) (baselineKeepFunc[pb.Option], error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	if len(fieldInfos) == 0 {
+		return func(newMsg *pb.Option, msg *pb.Option) {
+			*newMsg = *msg
+		}, nil
+	}
+
+	var subFuncs []baselineKeepFunc[pb.Option]
+	for _, field := range fieldInfos {
+		var fn baselineKeepFunc[pb.Option]
+		switch field.FieldName {
+		case "code":
+			fn = func(newMsg *pb.Option, msg *pb.Option) { newMsg.Code = msg.Code }
+		case "name":
+			fn = func(newMsg *pb.Option, msg *pb.Option) { newMsg.Name = msg.Name }
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if err := checkBaselineSimpleField(field); err != nil {
+			return nil, err
+		}
+		subFuncs = append(subFuncs, fn)
+	}
+	return joinBaselineKeepFuncs(subFuncs), nil
+}
+
+func newBaselineProductMask(maskedFields []string) (baselineKeepFunc[pb.Product], error) {
+	fieldInfos, err := computeProductFieldMaskInfos(maskedFields, nil)
+	if err != nil {
+		return nil, err
+	}
+	return computeBaselineProductKeepFunc(fieldInfos)
+}
+
+func TestBaselineProductMask(t *testing.T) {
+	keepFunc, err := newBaselineProductMask(benchmarkMaskedFields)
+	assert.Equal(t, nil, err)
+
+	fm, err := NewProductFieldMask(benchmarkMaskedFields)
+	assert.Equal(t, nil, err)
+
+	product := newProductForBenchmark()
+	newMsg := &pb.Product{}
+	keepFunc(newMsg, product)
+	assert.Equal(t, fm.Mask(product), newMsg)
+
+	_, err = newBaselineProductMask([]string{"provider.id.x"})
+	assert.Equal(t, fields.ErrFieldNotFound("provider.id.x"), err)
+}
+
+func BenchmarkBaseline_NewProductFieldMask(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, err := newBaselineProductMask(benchmarkMaskedFields)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func BenchmarkBaseline_ProductFieldMask_Mask(b *testing.B) {
+	keepFunc, err := newBaselineProductMask(benchmarkMaskedFields)
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		keepFunc(&pb.Product{}, product)
+	}
+}
+
+func BenchmarkBaseline_ProductFieldMask_Mask_Simple_Fields(b *testing.B) {
+	keepFunc, err := newBaselineProductMask([]string{"sku", "sellerIds", "brandCodes", "quantity"})
+	if err != nil {
+		panic(err)
+	}
+	product := newProductForBenchmark()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		keepFunc(&pb.Product{}, product)
+	}
+}
@@ -3,8 +3,10 @@ package generated
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/QuangTung97/fieldmask/fields"
 	"github.com/QuangTung97/fieldmask/testdata/pb"
@@ -363,3 +365,1739 @@ func TestProductFieldMask(t *testing.T) {
 		}, fm.Mask(p))
 	})
 }
+
+func TestItemFieldMask(t *testing.T) {
+	ts := types.TimestampNow()
+
+	bookItem := &pb.Item{
+		Id: "ITEM01",
+		Payload: &pb.Item_Book{
+			Book: &pb.Book{
+				Isbn:  "ISBN01",
+				Title: "Book Title",
+				Publisher: &pb.ProviderInfo{
+					Id:   21,
+					Name: "Provider Name",
+				},
+			},
+		},
+		Quantity: 3,
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		fm, err := NewItemFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, bookItem, fm.Mask(bookItem))
+	})
+
+	t.Run("oneof object member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"id", "book"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Item{
+			Id:      "ITEM01",
+			Payload: bookItem.Payload,
+		}, fm.Mask(bookItem))
+	})
+
+	t.Run("oneof object member with sub fields", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book.title", "book.publisher.name"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Item{
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{
+					Title: "Book Title",
+					Publisher: &pb.ProviderInfo{
+						Name: "Provider Name",
+					},
+				},
+			},
+		}, fm.Mask(bookItem))
+	})
+
+	t.Run("other oneof member selected", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"quantity", "name", "releasedAt"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Item{
+			Quantity: 3,
+		}, fm.Mask(bookItem))
+	})
+
+	t.Run("oneof simple member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"name", "book.title"})
+		assert.Equal(t, nil, err)
+
+		item := &pb.Item{
+			Id:      "ITEM02",
+			Payload: &pb.Item_Name{Name: "Item Name"},
+		}
+		assert.Equal(t, &pb.Item{
+			Payload: &pb.Item_Name{Name: "Item Name"},
+		}, fm.Mask(item))
+	})
+
+	t.Run("oneof special member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"releasedAt"})
+		assert.Equal(t, nil, err)
+
+		item := &pb.Item{
+			Id:      "ITEM03",
+			Payload: &pb.Item_ReleasedAt{ReleasedAt: ts},
+		}
+		assert.Equal(t, &pb.Item{
+			Payload: &pb.Item_ReleasedAt{ReleasedAt: ts},
+		}, fm.Mask(item))
+	})
+
+	t.Run("invalid sub fields of simple member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"name.value"})
+		assert.Equal(t, fields.ErrFieldNotFound("name.value"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("invalid sub fields of object member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book.publisher.sku"})
+		assert.Equal(t, fields.ErrFieldNotFound("book.publisher.sku"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func TestCatalogFieldMask(t *testing.T) {
+	ts := types.TimestampNow()
+
+	catalog := &pb.Catalog{
+		Code: "CATALOG01",
+		AttributesByCode: map[string]*pb.Attribute{
+			"ATTR01": {
+				Id:   31,
+				Code: "ATTR01",
+				Name: "Attr Name 01",
+				Options: []*pb.Option{
+					{Code: "OPTION01", Name: "Option Name 01"},
+				},
+			},
+			"ATTR02": {
+				Id:   32,
+				Code: "ATTR02",
+				Name: "Attr Name 02",
+			},
+		},
+		Providers: map[int32]*pb.ProviderInfo{
+			21: {Id: 21, Name: "Provider Name", Logo: "Provider Logo"},
+		},
+		Labels: map[string]string{
+			"key": "value",
+		},
+		UpdatedTimes: map[string]*types.Timestamp{
+			"ATTR01": ts,
+		},
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, catalog, fm.Mask(catalog))
+	})
+
+	t.Run("whole maps", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode", "labels", "updatedTimes"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Catalog{
+			AttributesByCode: catalog.AttributesByCode,
+			Labels:           catalog.Labels,
+			UpdatedTimes:     catalog.UpdatedTimes,
+		}, fm.Mask(catalog))
+	})
+
+	t.Run("sub fields of map values", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{
+			"code", "attributesByCode.name", "attributesByCode.options.code", "providers.{id|logo}",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Catalog{
+			Code: "CATALOG01",
+			AttributesByCode: map[string]*pb.Attribute{
+				"ATTR01": {
+					Name: "Attr Name 01",
+					Options: []*pb.Option{
+						{Code: "OPTION01"},
+					},
+				},
+				"ATTR02": {
+					Name:    "Attr Name 02",
+					Options: []*pb.Option{},
+				},
+			},
+			Providers: map[int32]*pb.ProviderInfo{
+				21: {Id: 21, Logo: "Provider Logo"},
+			},
+		}, fm.Mask(catalog))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode.name"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Catalog{
+			AttributesByCode: map[string]*pb.Attribute{},
+		}, fm.Mask(&pb.Catalog{Code: "CATALOG02"}))
+	})
+
+	t.Run("invalid sub fields of map values", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode.sku"})
+		assert.Equal(t, fields.ErrFieldNotFound("attributesByCode.sku"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("invalid sub fields of primitive map", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"labels.key"})
+		assert.Equal(t, fields.ErrFieldNotFound("labels.key"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func newProductForApply() *pb.Product {
+	return &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:   21,
+			Name: "Provider Name",
+			Logo: "Provider Logo",
+		},
+		Attributes: []*pb.Attribute{
+			{Id: 31, Code: "ATTR01", Name: "Attr Name 01"},
+		},
+		SellerIds: []int32{51, 52},
+	}
+}
+
+func TestProductFieldMask_Apply(t *testing.T) {
+	src := &pb.Product{
+		Sku: "SKU02",
+		Provider: &pb.ProviderInfo{
+			Id:   22,
+			Name: "New Provider Name",
+		},
+		Attributes: []*pb.Attribute{
+			{Id: 32, Code: "ATTR02", Name: "Attr Name 02"},
+		},
+		SellerIds: []int32{53},
+	}
+
+	t.Run("simple fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "brandCodes"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Sku = "SKU02"
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("clear unset fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider", "sellerIds"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, &pb.Product{})
+
+		assert.Equal(t, &pb.Product{
+			Attributes: newProductForApply().Attributes,
+		}, dst)
+	})
+
+	t.Run("replace nested message", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Provider = &pb.ProviderInfo{
+			Id:   22,
+			Name: "New Provider Name",
+		}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("replace shares fields with src", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider", "attributes"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		assert.Same(t, src.Provider, dst.Provider)
+		assert.Same(t, src.Attributes[0], dst.Attributes[0])
+
+		cloned := proto.Clone(src).(*pb.Product)
+		dst = newProductForApply()
+		fm.Apply(dst, cloned)
+
+		assert.NotSame(t, src.Provider, dst.Provider)
+		assert.Equal(t, src.Provider, dst.Provider)
+	})
+
+	t.Run("merge nested message", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Provider = &pb.ProviderInfo{
+			Id:   22,
+			Name: "New Provider Name",
+		}
+		assert.Equal(t, expected, dst)
+		assert.NotSame(t, src.Provider, dst.Provider)
+	})
+
+	t.Run("merge nested message, src is nil", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, &pb.Product{})
+
+		expected := newProductForApply()
+		expected.Provider = nil
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("sub fields of nested message", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.name", "provider.logo"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Provider = &pb.ProviderInfo{
+			Id:   21,
+			Name: "New Provider Name",
+		}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("sub fields of nested message, dst is nil", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.name"})
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{}
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Product{
+			Provider: &pb.ProviderInfo{
+				Name: "New Provider Name",
+			},
+		}, dst)
+
+		dst = &pb.Product{}
+		fm.Apply(dst, &pb.Product{})
+		assert.Equal(t, &pb.Product{}, dst)
+	})
+
+	t.Run("replace repeated fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes", "sellerIds"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Attributes = src.Attributes
+		expected.SellerIds = []int32{53}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("append repeated fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes", "sellerIds"}, fields.WithAppendRepeatedFields())
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Attributes = []*pb.Attribute{
+			{Id: 31, Code: "ATTR01", Name: "Attr Name 01"},
+			{Id: 32, Code: "ATTR02", Name: "Attr Name 02"},
+		}
+		expected.SellerIds = []int32{51, 52, 53}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("sub fields of repeated fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes.code"})
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Attributes = []*pb.Attribute{
+			{Code: "ATTR02"},
+		}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("sub fields of repeated fields, append", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes.code"}, fields.WithAppendRepeatedFields())
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		expected := newProductForApply()
+		expected.Attributes = []*pb.Attribute{
+			{Id: 31, Code: "ATTR01", Name: "Attr Name 01"},
+			{Code: "ATTR02"},
+		}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("empty mask apply all fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+		assert.Equal(t, src, dst)
+	})
+
+	t.Run("empty mask apply all fields, with merge and append", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil, fields.WithMergeNestedMessages(), fields.WithAppendRepeatedFields())
+		assert.Equal(t, nil, err)
+
+		dst := newProductForApply()
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU02",
+			Provider: &pb.ProviderInfo{
+				Id:   22,
+				Name: "New Provider Name",
+			},
+			Attributes: []*pb.Attribute{
+				{Id: 31, Code: "ATTR01", Name: "Attr Name 01"},
+				{Id: 32, Code: "ATTR02", Name: "Attr Name 02"},
+			},
+			SellerIds: []int32{51, 52, 53},
+		}, dst)
+	})
+}
+
+func TestItemFieldMask_Apply(t *testing.T) {
+	newBookItem := func() *pb.Item {
+		return &pb.Item{
+			Id: "ITEM01",
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{Isbn: "ISBN01", Title: "Book Title"},
+			},
+		}
+	}
+
+	t.Run("set other member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"name"})
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		fm.Apply(dst, &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}})
+
+		assert.Equal(t, &pb.Item{
+			Id:      "ITEM01",
+			Payload: &pb.Item_Name{Name: "Item Name"},
+		}, dst)
+	})
+
+	t.Run("clear member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book"})
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		fm.Apply(dst, &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}})
+
+		assert.Equal(t, &pb.Item{Id: "ITEM01"}, dst)
+	})
+
+	t.Run("not clear other member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"name"})
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		fm.Apply(dst, &pb.Item{})
+
+		assert.Equal(t, newBookItem(), dst)
+	})
+
+	t.Run("sub fields of member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book.title"})
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		fm.Apply(dst, &pb.Item{
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{Isbn: "ISBN02", Title: "New Title"},
+			},
+		})
+
+		assert.Equal(t, &pb.Item{
+			Id: "ITEM01",
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{Isbn: "ISBN01", Title: "New Title"},
+			},
+		}, dst)
+
+		dst = &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}}
+		fm.Apply(dst, &pb.Item{
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{Isbn: "ISBN02", Title: "New Title"},
+			},
+		})
+		assert.Equal(t, &pb.Item{
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{Title: "New Title"},
+			},
+		}, dst)
+	})
+
+	t.Run("wrapper is not shared with src", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"name", "book"})
+		assert.Equal(t, nil, err)
+
+		src := newBookItem()
+		dst := &pb.Item{}
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Item{Payload: src.Payload}, dst)
+		assert.NotSame(t, src.Payload, dst.Payload)
+
+		src = &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}}
+		fm.Apply(dst, src)
+		assert.Equal(t, &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}}, dst)
+		assert.NotSame(t, src.Payload, dst.Payload)
+	})
+
+	t.Run("merge nested message", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		dst.GetBook().Publisher = &pb.ProviderInfo{Id: 21, Name: "Publisher"}
+		dstBook := dst.GetBook()
+		dstPublisher := dstBook.Publisher
+
+		src := &pb.Item{
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{
+					Isbn:      "ISBN02",
+					Publisher: &pb.ProviderInfo{Id: 22, Name: "New Publisher"},
+				},
+			},
+		}
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Item{
+			Id: "ITEM01",
+			Payload: &pb.Item_Book{
+				Book: &pb.Book{
+					Isbn:      "ISBN02",
+					Publisher: &pb.ProviderInfo{Id: 22, Name: "New Publisher"},
+				},
+			},
+		}, dst)
+		assert.Same(t, dstBook, dst.GetBook())
+		assert.Same(t, dstPublisher, dst.GetBook().Publisher)
+		assert.NotSame(t, src.Payload, dst.Payload)
+	})
+
+	t.Run("merge nested message, dst is other member", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		src := newBookItem()
+		dst := &pb.Item{Payload: &pb.Item_Name{Name: "Item Name"}}
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Item{Payload: src.Payload}, dst)
+		assert.NotSame(t, src.GetBook(), dst.GetBook())
+	})
+
+	t.Run("merge nested message, src member is nil", func(t *testing.T) {
+		fm, err := NewItemFieldMask([]string{"book"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		dst := newBookItem()
+		fm.Apply(dst, &pb.Item{Payload: &pb.Item_Book{}})
+
+		assert.Equal(t, &pb.Item{Id: "ITEM01", Payload: &pb.Item_Book{}}, dst)
+	})
+}
+
+func TestCatalogFieldMask_Apply(t *testing.T) {
+	newCatalog := func() *pb.Catalog {
+		return &pb.Catalog{
+			AttributesByCode: map[string]*pb.Attribute{
+				"ATTR01": {Id: 31, Code: "ATTR01"},
+			},
+			Labels: map[string]string{"a": "1", "b": "2"},
+		}
+	}
+
+	src := &pb.Catalog{
+		AttributesByCode: map[string]*pb.Attribute{
+			"ATTR02": {Id: 32, Code: "ATTR02"},
+		},
+		Labels: map[string]string{"b": "3"},
+	}
+
+	t.Run("replace maps", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode.code", "labels"})
+		assert.Equal(t, nil, err)
+
+		dst := newCatalog()
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Catalog{
+			AttributesByCode: map[string]*pb.Attribute{
+				"ATTR02": {Code: "ATTR02"},
+			},
+			Labels: map[string]string{"b": "3"},
+		}, dst)
+	})
+
+	t.Run("merge maps", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode.code", "labels"}, fields.WithAppendRepeatedFields())
+		assert.Equal(t, nil, err)
+
+		dst := newCatalog()
+		fm.Apply(dst, src)
+
+		assert.Equal(t, &pb.Catalog{
+			AttributesByCode: map[string]*pb.Attribute{
+				"ATTR01": {Id: 31, Code: "ATTR01"},
+				"ATTR02": {Code: "ATTR02"},
+			},
+			Labels: map[string]string{"a": "1", "b": "3"},
+		}, dst)
+	})
+}
+
+func TestProductExcludeMask(t *testing.T) {
+	ts := types.TimestampNow()
+
+	product := &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			Logo:     "Provider Logo",
+			ImageUrl: "provider-image-url",
+		},
+		Attributes: []*pb.Attribute{
+			{
+				Id:   31,
+				Code: "ATTR01",
+				Name: "Attr Name 01",
+				Options: []*pb.Option{
+					{Code: "OPTION01", Name: "Option Name 01"},
+				},
+			},
+		},
+		SellerIds:  []int32{51, 52},
+		BrandCodes: []string{"BRAND01"},
+		CreatedAt:  ts,
+		Quantity:   &types.DoubleValue{Value: 886},
+	}
+
+	t.Run("exclude nothing", func(t *testing.T) {
+		fm, err := NewProductExcludeMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, product, fm.Mask(product))
+	})
+
+	t.Run("exclude all", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{"*"})
+		assert.Nil(t, fm)
+		assert.Equal(t, fields.ErrExcludeAllFields, err)
+	})
+
+	t.Run("to proto round trip", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{"provider.*", "attributes", "sellerIds", "brandCodes"})
+		assert.Equal(t, nil, err)
+
+		fieldMask := fm.ToProto()
+		assert.Equal(t, []string{"sku", "created_at", "quantity", "stocks"}, fieldMask.Paths)
+
+		fromProto, err := NewProductFieldMaskFromProto(fieldMask)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, fm.Mask(product), fromProto.Mask(product))
+		assert.Equal(t, fm.GetMaskedFields(), fromProto.GetMaskedFields())
+	})
+
+	t.Run("exclude simple and nested fields", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{
+			"sellerIds", "provider.imageUrl", "attributes.options.name",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU01",
+			Provider: &pb.ProviderInfo{
+				Id:   21,
+				Name: "Provider Name",
+				Logo: "Provider Logo",
+			},
+			Attributes: []*pb.Attribute{
+				{
+					Id:   31,
+					Code: "ATTR01",
+					Name: "Attr Name 01",
+					Options: []*pb.Option{
+						{Code: "OPTION01"},
+					},
+				},
+			},
+			BrandCodes: []string{"BRAND01"},
+			CreatedAt:  ts,
+			Quantity:   &types.DoubleValue{Value: 886},
+		}, fm.Mask(product))
+
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{
+					{FieldName: "id"},
+					{FieldName: "name"},
+					{FieldName: "logo"},
+				},
+			},
+			{
+				FieldName: "attributes",
+				SubFields: []fields.FieldInfo{
+					{FieldName: "id"},
+					{FieldName: "code"},
+					{FieldName: "name"},
+					{
+						FieldName: "options",
+						SubFields: []fields.FieldInfo{
+							{FieldName: "code"},
+						},
+					},
+				},
+			},
+			{FieldName: "brandCodes"},
+			{FieldName: "createdAt"},
+			{FieldName: "quantity"},
+			{FieldName: "stocks"},
+		}, fm.GetMaskedFields())
+	})
+
+	t.Run("exclude all sub fields of nested field", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{
+			"provider.{id|name|logo|imageUrl}", "attributes", "stocks",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku:        "SKU01",
+			SellerIds:  []int32{51, 52},
+			BrandCodes: []string{"BRAND01"},
+			CreatedAt:  ts,
+			Quantity:   &types.DoubleValue{Value: 886},
+		}, fm.Mask(product))
+	})
+
+	t.Run("exclude all fields", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{
+			"sku", "provider", "attributes", "sellerIds", "brandCodes", "createdAt", "quantity", "stocks",
+		})
+		assert.Nil(t, fm)
+		assert.Equal(t, fields.ErrExcludeAllFields, err)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{
+			"sku", "provider.name", "attributes", "sellerIds", "brandCodes", "createdAt", "quantity", "stocks",
+		})
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{
+			Sku: "SKU02",
+			Provider: &pb.ProviderInfo{
+				Id:   22,
+				Name: "Old Name",
+			},
+		}
+		fm.Apply(dst, product)
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU02",
+			Provider: &pb.ProviderInfo{
+				Id:       21,
+				Name:     "Old Name",
+				Logo:     "Provider Logo",
+				ImageUrl: "provider-image-url",
+			},
+		}, dst)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{"provider.sku"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("sku"), "provider"), err)
+		assert.Nil(t, fm)
+
+		fm, err = NewProductExcludeMask([]string{"sellerIds.id"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("id"), "sellerIds"), err)
+		assert.Nil(t, fm)
+
+		fm, err = NewProductExcludeMask([]string{"unknown"})
+		assert.Equal(t, fields.ErrFieldNotFound("unknown"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func TestItemExcludeMask(t *testing.T) {
+	fm, err := NewItemExcludeMask([]string{"book.publisher.logo", "quantity"})
+	assert.Equal(t, nil, err)
+
+	item := &pb.Item{
+		Id: "ITEM01",
+		Payload: &pb.Item_Book{
+			Book: &pb.Book{
+				Isbn:      "ISBN01",
+				Publisher: &pb.ProviderInfo{Id: 21, Logo: "logo"},
+			},
+		},
+		Quantity: 5,
+	}
+	assert.Equal(t, &pb.Item{
+		Id: "ITEM01",
+		Payload: &pb.Item_Book{
+			Book: &pb.Book{
+				Isbn:      "ISBN01",
+				Publisher: &pb.ProviderInfo{Id: 21},
+			},
+		},
+	}, fm.Mask(item))
+}
+
+func TestProductFieldMask_WithNameStyle(t *testing.T) {
+	product := &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			ImageUrl: "provider-image-url",
+		},
+		SellerIds:  []int32{51, 52},
+		BrandCodes: []string{"BRAND01"},
+	}
+
+	t.Run("json style by default", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.image_url"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("image_url"), "provider"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("proto style", func(t *testing.T) {
+		fm, err := NewProductFieldMask(
+			[]string{"sku", "provider.image_url", "seller_ids"},
+			fields.WithNameStyle(fields.NameStyleProto),
+		)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU01",
+			Provider: &pb.ProviderInfo{
+				ImageUrl: "provider-image-url",
+			},
+			SellerIds: []int32{51, 52},
+		}, fm.Mask(product))
+
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "sku"},
+			{
+				FieldName: "provider",
+				SubFields: []fields.FieldInfo{
+					{FieldName: "imageUrl"},
+				},
+			},
+			{FieldName: "sellerIds"},
+		}, fm.GetMaskedFields())
+	})
+
+	t.Run("proto style not accept json names", func(t *testing.T) {
+		fm, err := NewProductFieldMask(
+			[]string{"sellerIds"},
+			fields.WithNameStyle(fields.NameStyleProto),
+		)
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("both styles", func(t *testing.T) {
+		fm, err := NewProductFieldMask(
+			[]string{"provider.{id|image_url}", "brandCodes"},
+			fields.WithNameStyle(fields.NameStyleBoth),
+		)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Provider: &pb.ProviderInfo{
+				Id:       21,
+				ImageUrl: "provider-image-url",
+			},
+			BrandCodes: []string{"BRAND01"},
+		}, fm.Mask(product))
+	})
+
+	t.Run("both styles with duplicated names", func(t *testing.T) {
+		fm, err := NewProductFieldMask(
+			[]string{"brandCodes", "brand_codes"},
+			fields.WithNameStyle(fields.NameStyleBoth),
+		)
+		assert.Equal(t, fields.ErrDuplicatedField("brandCodes"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("exclude mask", func(t *testing.T) {
+		fm, err := NewProductExcludeMask(
+			[]string{"provider.image_url", "seller_ids", "brand_codes", "created_at", "quantity", "stocks", "attributes"},
+			fields.WithNameStyle(fields.NameStyleProto),
+		)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku: "SKU01",
+			Provider: &pb.ProviderInfo{
+				Id:   21,
+				Name: "Provider Name",
+			},
+		}, fm.Mask(product))
+	})
+
+	t.Run("compute mask accepts all aliases", func(t *testing.T) {
+		mask, err := pb_Product_ComputeMask([]fields.FieldInfo{
+			{FieldName: "seller_ids"},
+			{FieldName: "brandCodes"},
+		})
+		assert.Equal(t, nil, err)
+
+		newMsg := &pb.Product{}
+		pb_Product_Keep(mask, newMsg, product)
+		assert.Equal(t, &pb.Product{
+			SellerIds:  []int32{51, 52},
+			BrandCodes: []string{"BRAND01"},
+		}, newMsg)
+	})
+}
+
+func TestProductFieldMask_FromProto(t *testing.T) {
+	product := &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			ImageUrl: "provider-image-url",
+		},
+		SellerIds: []int32{51, 52},
+	}
+
+	t.Run("proto and json names", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id", "provider.image_url", "sellerIds"},
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Provider: &pb.ProviderInfo{
+				Id:       21,
+				ImageUrl: "provider-image-url",
+			},
+			SellerIds: []int32{51, 52},
+		}, fm.Mask(product))
+
+		assert.Equal(t, &fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id", "provider.imageUrl", "sellerIds"},
+		}, fields.ToFieldMask(fm.GetMaskedFields()))
+
+		assert.Equal(t, &fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id", "provider.image_url", "seller_ids"},
+		}, fm.ToProto())
+	})
+
+	t.Run("merged paths", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id", "provider", "sku", "sku"},
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: product.Provider,
+		}, fm.Mask(product))
+		assert.Equal(t, &fieldmaskpb.FieldMask{
+			Paths: []string{"provider", "sku"},
+		}, fm.ToProto())
+	})
+
+	t.Run("bracket paths are not allowed", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"provider.{id|name}"},
+		})
+		assert.Equal(t, "fields: invalid field mask path 'provider.{id|name}'", err.Error())
+		assert.Nil(t, fm)
+	})
+
+	t.Run("nil field mask", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromProto(nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, product, fm.Mask(product))
+	})
+
+	t.Run("override name style", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"sellerIds"},
+		}, fields.WithNameStyle(fields.NameStyleProto))
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("limited to fields", func(t *testing.T) {
+		limitedTo := fields.WithLimitedToFields([]string{"sku", "provider.imageUrl"})
+
+		fm, err := NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id"},
+		}, limitedTo)
+		assert.Equal(t, fields.ErrFieldNotFound("provider.id"), err)
+		assert.Nil(t, fm)
+
+		fm, err = NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"sku", "provider.image_url"},
+		}, limitedTo)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU01",
+			Provider: &pb.ProviderInfo{ImageUrl: "provider-image-url"},
+		}, fm.Mask(product))
+
+		excludeMask, err := NewProductExcludeMask([]string{"seller_ids"},
+			fields.WithNameStyle(fields.NameStyleProto), limitedTo,
+		)
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, excludeMask)
+
+		fm, err = NewProductFieldMaskFromProto(&fieldmaskpb.FieldMask{
+			Paths: []string{"provider.id"},
+		}, limitedTo, fields.WithNameStyle(fields.NameStyleJSON))
+		assert.Equal(t, fields.ErrFieldNotFound("provider.id"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func TestProductFieldMask_ToProto(t *testing.T) {
+	t.Run("proto names", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.{id|imageUrl}", "attributes.options.code", "brandCodes"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &fieldmaskpb.FieldMask{
+			Paths: []string{
+				"sku",
+				"provider.id",
+				"provider.image_url",
+				"attributes.options.code",
+				"brand_codes",
+			},
+		}, fm.ToProto())
+
+		newFm, err := NewProductFieldMaskFromProto(fm.ToProto())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, fm.GetMaskedFields(), newFm.GetMaskedFields())
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.*"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &fieldmaskpb.FieldMask{
+			Paths: []string{"sku", "provider.id", "provider.name", "provider.logo", "provider.image_url"},
+		}, fm.ToProto())
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &fieldmaskpb.FieldMask{}, fm.ToProto())
+	})
+}
+
+func TestProductFieldMask_Wildcard(t *testing.T) {
+	product := &pb.Product{
+		Sku: "SKU01",
+		Provider: &pb.ProviderInfo{
+			Id:       21,
+			Name:     "Provider Name",
+			ImageUrl: "provider-image-url",
+		},
+		Attributes: []*pb.Attribute{
+			{
+				Id:   31,
+				Code: "ATTR01",
+				Options: []*pb.Option{
+					{Code: "OPTION01", Name: "Option Name 01"},
+				},
+			},
+		},
+		SellerIds: []int32{51, 52},
+	}
+
+	t.Run("top level", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"*"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, product, fm.Mask(product))
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: fields.Wildcard},
+		}, fm.GetMaskedFields())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.*", "attributes.{id|options.*}"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Provider: product.Provider,
+			Attributes: []*pb.Attribute{
+				{
+					Id: 31,
+					Options: []*pb.Option{
+						{Code: "OPTION01", Name: "Option Name 01"},
+					},
+				},
+			},
+		}, fm.Mask(product))
+	})
+
+	t.Run("with invalid sibling", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.{*|sku}"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("sku"), "provider"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.*"})
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{
+			Sku:      "SKU02",
+			Provider: &pb.ProviderInfo{Logo: "old-logo"},
+		}
+		fm.Apply(dst, product)
+
+		assert.Equal(t, &pb.Product{
+			Sku:      "SKU02",
+			Provider: product.Provider,
+		}, dst)
+	})
+
+	t.Run("exclude", func(t *testing.T) {
+		fm, err := NewProductExcludeMask([]string{"provider.*", "attributes", "sellerIds"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &pb.Product{Sku: "SKU01"}, fm.Mask(product))
+	})
+}
+
+func TestProductFieldMask_MaskInto(t *testing.T) {
+	maskedFields := []string{"sku", "provider.{id|name}", "attributes.{id|options.code}", "sellerIds"}
+
+	t.Run("same as mask", func(t *testing.T) {
+		fm, err := NewProductFieldMask(maskedFields)
+		assert.Equal(t, nil, err)
+
+		product := newProductForBenchmark()
+
+		dst := &pb.Product{}
+		fm.MaskInto(dst, product)
+		assert.Equal(t, fm.Mask(product), dst)
+	})
+
+	t.Run("reuse sub messages and clear old fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(maskedFields)
+		assert.Equal(t, nil, err)
+
+		oldProvider := &pb.ProviderInfo{Id: 11, Logo: "old-logo"}
+		oldAttr := &pb.Attribute{Id: 12, Code: "OLD"}
+		dst := &pb.Product{
+			Sku:        "OLD-SKU",
+			Provider:   oldProvider,
+			Attributes: []*pb.Attribute{oldAttr, {Id: 13}},
+			BrandCodes: []string{"OLD-BRAND"},
+		}
+
+		product := newProductForBenchmark()
+		fm.MaskInto(dst, product)
+
+		assert.Equal(t, fm.Mask(product), dst)
+		assert.Same(t, oldProvider, dst.Provider)
+		assert.Same(t, oldAttr, dst.Attributes[0])
+		assert.Equal(t, 10, len(dst.Attributes))
+	})
+
+	t.Run("nil sub messages", func(t *testing.T) {
+		fm, err := NewProductFieldMask(maskedFields)
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{
+			Provider:   &pb.ProviderInfo{Id: 11},
+			Attributes: []*pb.Attribute{{Id: 12}},
+		}
+		fm.MaskInto(dst, &pb.Product{
+			Sku:        "SKU01",
+			Attributes: []*pb.Attribute{nil, {Id: 31, Name: "Attr"}},
+		})
+
+		assert.Equal(t, &pb.Product{
+			Sku:        "SKU01",
+			Attributes: []*pb.Attribute{nil, {Id: 31}},
+		}, dst)
+	})
+
+	t.Run("empty mask", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		product := newProductForBenchmark()
+
+		dst := &pb.Product{Sku: "OLD-SKU"}
+		fm.MaskInto(dst, product)
+		assert.Equal(t, product, dst)
+		assert.NotSame(t, product.Provider, dst.Provider)
+		assert.NotSame(t, product.Attributes[0], dst.Attributes[0])
+	})
+
+	t.Run("whole field then sub fields into the same dst", func(t *testing.T) {
+		whole, err := NewProductFieldMask([]string{"attributes"})
+		assert.Equal(t, nil, err)
+		part, err := NewProductFieldMask([]string{"attributes.options.code"})
+		assert.Equal(t, nil, err)
+
+		src1 := &pb.Product{
+			Attributes: []*pb.Attribute{
+				{Id: 11, Options: []*pb.Option{{Code: "c1", Name: "Option 1"}}},
+			},
+		}
+		src2 := &pb.Product{
+			Attributes: []*pb.Attribute{
+				{Id: 12, Options: []*pb.Option{{Code: "c2", Name: "Option 2"}}},
+			},
+		}
+
+		dst := &pb.Product{}
+		whole.MaskInto(dst, src1)
+		assert.Equal(t, src1, dst)
+
+		part.MaskInto(dst, src2)
+		assert.Equal(t, &pb.Product{
+			Attributes: []*pb.Attribute{
+				{Options: []*pb.Option{{Code: "c2"}}},
+			},
+		}, dst)
+
+		assert.Equal(t, "c1", src1.Attributes[0].Options[0].Code)
+		assert.Equal(t, &pb.Product{
+			Attributes: []*pb.Attribute{
+				{Id: 11, Options: []*pb.Option{{Code: "c1", Name: "Option 1"}}},
+			},
+		}, src1)
+	})
+}
+
+func TestProductFieldMask_MaskSlice(t *testing.T) {
+	fm, err := NewProductFieldMask([]string{"sku", "provider.id"})
+	assert.Equal(t, nil, err)
+
+	src := []*pb.Product{
+		{Sku: "SKU01", Provider: &pb.ProviderInfo{Id: 21, Name: "Provider 01"}},
+		nil,
+		{Sku: "SKU02", SellerIds: []int32{51}},
+	}
+
+	t.Run("empty dst", func(t *testing.T) {
+		result := fm.MaskSlice(nil, src)
+		assert.Equal(t, []*pb.Product{
+			{Sku: "SKU01", Provider: &pb.ProviderInfo{Id: 21}},
+			nil,
+			{Sku: "SKU02"},
+		}, result)
+	})
+
+	t.Run("reuse capacity", func(t *testing.T) {
+		oldMsg := &pb.Product{Sku: "OLD", BrandCodes: []string{"BRAND01"}}
+		dst := make([]*pb.Product, 0, 4)
+		dst = append(dst, oldMsg)
+
+		result := fm.MaskSlice(dst, src)
+		assert.Equal(t, []*pb.Product{
+			{Sku: "SKU01", Provider: &pb.ProviderInfo{Id: 21}},
+			nil,
+			{Sku: "SKU02"},
+		}, result)
+		assert.Same(t, oldMsg, result[0])
+		assert.Same(t, &dst[:1][0], &result[0])
+	})
+
+	t.Run("whole messages then sub fields into the same dst", func(t *testing.T) {
+		whole, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		src1 := []*pb.Product{{Sku: "SKU01", Provider: &pb.ProviderInfo{Id: 21, Name: "Provider 01"}}}
+		src2 := []*pb.Product{{Sku: "SKU02", Provider: &pb.ProviderInfo{Id: 22, Name: "Provider 02"}}}
+
+		dst := whole.MaskSlice(nil, src1)
+		dst = fm.MaskSlice(dst, src2)
+		assert.Equal(t, []*pb.Product{
+			{Sku: "SKU02", Provider: &pb.ProviderInfo{Id: 22}},
+		}, dst)
+		assert.Equal(t, &pb.ProviderInfo{Id: 21, Name: "Provider 01"}, src1[0].Provider)
+	})
+}
+
+func TestProductFieldMask_MaskInPlace(t *testing.T) {
+	t.Run("nested fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.{id|name}", "attributes.{id|options.code}"})
+		assert.Equal(t, nil, err)
+
+		product := newProductForBenchmark()
+		expected := fm.Mask(product)
+
+		provider := product.Provider
+		attr := product.Attributes[0]
+
+		fm.MaskInPlace(product)
+		assert.Equal(t, expected, product)
+		assert.Same(t, provider, product.Provider)
+		assert.Same(t, attr, product.Attributes[0])
+	})
+
+	t.Run("empty mask", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		product := newProductForBenchmark()
+		fm.MaskInPlace(product)
+		assert.Equal(t, newProductForBenchmark().Provider, product.Provider)
+		assert.Equal(t, 10, len(product.Attributes))
+	})
+
+	t.Run("oneof and maps", func(t *testing.T) {
+		itemMask, err := NewItemFieldMask([]string{"book.isbn"})
+		assert.Equal(t, nil, err)
+
+		item := &pb.Item{
+			Id:       "ITEM01",
+			Payload:  &pb.Item_Book{Book: &pb.Book{Isbn: "ISBN01", Title: "Title"}},
+			Quantity: 3,
+		}
+		itemMask.MaskInPlace(item)
+		assert.Equal(t, &pb.Item{
+			Payload: &pb.Item_Book{Book: &pb.Book{Isbn: "ISBN01"}},
+		}, item)
+
+		catalogMask, err := NewCatalogFieldMask([]string{"providers.name", "labels"})
+		assert.Equal(t, nil, err)
+
+		catalog := &pb.Catalog{
+			Code: "CATALOG01",
+			Providers: map[int32]*pb.ProviderInfo{
+				21: {Id: 21, Name: "Provider 01"},
+			},
+			Labels: map[string]string{"a": "1"},
+		}
+		catalogMask.MaskInPlace(catalog)
+		assert.Equal(t, &pb.Catalog{
+			Providers: map[int32]*pb.ProviderInfo{
+				21: {Name: "Provider 01"},
+			},
+			Labels: map[string]string{"a": "1"},
+		}, catalog)
+	})
+}
+
+func TestProductPaths(t *testing.T) {
+	t.Run("paths", func(t *testing.T) {
+		assert.Equal(t, fields.Path("sku"), ProductPaths.Sku())
+		assert.Equal(t, fields.Path("provider"), ProductPaths.Provider().Path())
+		assert.Equal(t, fields.Path("provider.imageUrl"), ProductPaths.Provider().ImageUrl())
+		assert.Equal(t, fields.Path("attributes.options.code"), ProductPaths.Attributes().Options().Code())
+		assert.Equal(t, fields.Path("attributesByCode.name"), CatalogPaths.AttributesByCode().Name())
+		assert.Equal(t, fields.Path("book.publisher.logo"), ItemPaths.Book().Publisher().Logo())
+	})
+
+	t.Run("new field mask from paths", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromPaths([]fields.Path{
+			ProductPaths.Sku(),
+			ProductPaths.Provider().Name(),
+			ProductPaths.Attributes().Options().Code(),
+		})
+		assert.Equal(t, nil, err)
+
+		expected, err := NewProductFieldMask([]string{"sku", "provider.name", "attributes.options.code"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, expected, fm)
+	})
+
+	t.Run("same errors as strings", func(t *testing.T) {
+		fm, err := NewProductFieldMaskFromPaths([]fields.Path{
+			ProductPaths.Provider().Path(), ProductPaths.Provider().Name(),
+		})
+		assert.Equal(t, fields.ErrDuplicatedField("provider"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		paths := []fields.Path{ProductPaths.Sku(), ProductPaths.SellerIds()}
+
+		fm, err := NewProductFieldMaskFromPaths(paths, fields.WithLimitedToFields([]string{"sku"}))
+		assert.Equal(t, fields.ErrFieldNotFound("sellerIds"), err)
+		assert.Nil(t, fm)
+
+		fm, err = NewProductFieldMaskFromPaths(paths, fields.WithAppendRepeatedFields())
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{Sku: "SKU01", SellerIds: []int32{51}}
+		fm.Apply(dst, &pb.Product{Sku: "SKU02", SellerIds: []int32{52}})
+		assert.Equal(t, &pb.Product{Sku: "SKU02", SellerIds: []int32{51, 52}}, dst)
+	})
+}
+
+func TestProductFieldMask_Has(t *testing.T) {
+	t.Run("all fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Has("sku"))
+		assert.Equal(t, true, fm.Has("provider"))
+		assert.Equal(t, true, fm.Has("attributes.options.code"))
+		assert.Equal(t, true, fm.HasAny("provider.imageUrl"))
+
+		assert.Equal(t, false, fm.Has("provider.unknown"))
+		assert.Equal(t, false, fm.Has("sku.name"))
+		assert.Equal(t, false, fm.Has(""))
+	})
+
+	t.Run("sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.name", "attributes"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Has("sku"))
+		assert.Equal(t, false, fm.Has("sellerIds"))
+
+		assert.Equal(t, false, fm.Has("provider"))
+		assert.Equal(t, true, fm.HasAny("provider"))
+		assert.Equal(t, true, fm.Has("provider.name"))
+		assert.Equal(t, false, fm.HasAny("provider.logo"))
+
+		assert.Equal(t, true, fm.Has("attributes.options"))
+		assert.Equal(t, true, fm.Has("attributes.options.code"))
+	})
+
+	t.Run("proto names", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.imageUrl"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Has("provider.image_url"))
+		assert.Equal(t, true, fm.Has("provider.imageUrl"))
+	})
+
+	t.Run("wildcard sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.*", "attributes.{*|options.code}", "sku"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Has("provider"))
+		assert.Equal(t, true, fm.HasAny("provider"))
+		assert.Equal(t, true, fm.Has("provider.name"))
+
+		assert.Equal(t, true, fm.Has("attributes"))
+		assert.Equal(t, true, fm.Has("attributes.options"))
+		assert.Equal(t, true, fm.Has("attributes.options.name"))
+		assert.Equal(t, false, fm.Has("sellerIds"))
+	})
+
+	t.Run("nested wildcard sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes.options.*"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, false, fm.Has("attributes"))
+		assert.Equal(t, true, fm.HasAny("attributes"))
+		assert.Equal(t, true, fm.Has("attributes.options"))
+	})
+}
+
+func TestProductFieldMask_Selection(t *testing.T) {
+	t.Run("sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.logo", "attributes.options"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Sku())
+		assert.Equal(t, false, fm.SellerIds())
+
+		assert.Equal(t, true, fm.Provider().Selected())
+		assert.Equal(t, true, fm.Provider().Logo())
+		assert.Equal(t, false, fm.Provider().Name())
+
+		assert.Equal(t, true, fm.Attributes().Selected())
+		assert.Equal(t, false, fm.Attributes().Code())
+		assert.Equal(t, true, fm.Attributes().Options().Selected())
+		assert.Equal(t, true, fm.Attributes().Options().Code())
+	})
+
+	t.Run("not selected", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, false, fm.Provider().Selected())
+		assert.Equal(t, false, fm.Provider().Logo())
+		assert.Equal(t, false, fm.Attributes().Options().Code())
+	})
+
+	t.Run("whole field", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Attributes().Options().Name())
+		assert.Equal(t, false, fm.Provider().Name())
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, true, fm.Selection().Selected())
+		assert.Equal(t, true, fm.Provider().ImageUrl())
+		assert.Equal(t, true, fm.Attributes().Options().Name())
+	})
+
+	t.Run("oneof and map", func(t *testing.T) {
+		itemMask, err := NewItemFieldMask([]string{"book.publisher.name"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, itemMask.Book().Publisher().Name())
+		assert.Equal(t, false, itemMask.Book().Title())
+
+		catalogMask, err := NewCatalogFieldMask([]string{"providers.logo"})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, catalogMask.Providers().Logo())
+		assert.Equal(t, false, catalogMask.AttributesByCode().Selected())
+	})
+}
+
+func TestProductFieldMask_ProviderMask(t *testing.T) {
+	provider := &pb.ProviderInfo{Id: 21, Name: "Provider Name", Logo: "logo.png", ImageUrl: "image.png"}
+
+	t.Run("sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider.{id|logo}"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.ProviderMask()
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "id"}, {FieldName: "logo"}}, subMask.GetMaskedFields())
+		assert.Equal(t, &pb.ProviderInfo{Id: 21, Logo: "logo.png"}, subMask.Mask(provider))
+		assert.Equal(t, true, subMask.Has("logo"))
+		assert.Equal(t, false, subMask.Has("name"))
+	})
+
+	t.Run("whole field", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "provider"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.ProviderMask()
+		assert.Nil(t, subMask.GetMaskedFields())
+		assert.Equal(t, provider, subMask.Mask(provider))
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask(nil)
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, provider, fm.ProviderMask().Mask(provider))
+	})
+
+	t.Run("wildcard with sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"*", "provider.id"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.ProviderMask()
+		assert.Nil(t, subMask.GetMaskedFields())
+		assert.Equal(t, provider, subMask.Mask(provider))
+	})
+
+	t.Run("not selected", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku"})
+		assert.Equal(t, nil, err)
+		assert.Nil(t, fm.ProviderMask())
+
+		fm, err = NewProductExcludeMask([]string{"provider"})
+		assert.Equal(t, nil, err)
+		assert.Nil(t, fm.ProviderMask())
+	})
+
+	t.Run("keep apply options", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"provider.name"}, fields.WithMergeNestedMessages())
+		assert.Equal(t, nil, err)
+
+		dst := &pb.ProviderInfo{Id: 11, Name: "Old Name"}
+		fm.ProviderMask().Apply(dst, provider)
+		assert.Equal(t, &pb.ProviderInfo{Id: 11, Name: "Provider Name"}, dst)
+	})
+
+	t.Run("map of messages", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"code", "providers.name"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.ProvidersMask()
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "name"}}, subMask.GetMaskedFields())
+		assert.Equal(t, &pb.ProviderInfo{Name: "Provider Name"}, subMask.Mask(provider))
+	})
+}
+
+func TestProductFieldMask_AttributesMask(t *testing.T) {
+	attr := &pb.Attribute{
+		Id:   31,
+		Code: "ATTR01",
+		Name: "Attr Name",
+		Options: []*pb.Option{
+			{Code: "OPT01", Name: "Option Name"},
+		},
+	}
+
+	t.Run("repeated messages", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "attributes.{id|options.code}"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.AttributesMask()
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "id"},
+			{FieldName: "options", SubFields: []fields.FieldInfo{{FieldName: "code"}}},
+		}, subMask.GetMaskedFields())
+		assert.Equal(t, &pb.Attribute{
+			Id:      31,
+			Options: []*pb.Option{{Code: "OPT01"}},
+		}, subMask.Mask(attr))
+		assert.Equal(t, true, subMask.Has("id"))
+		assert.Equal(t, false, subMask.Has("name"))
+		assert.Equal(t, []string{"id", "options.code"}, subMask.ToProto().Paths)
+
+		optionsMask := subMask.OptionsMask()
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "code"}}, optionsMask.GetMaskedFields())
+		assert.Equal(t, &pb.Option{Code: "OPT01"}, optionsMask.Mask(attr.Options[0]))
+	})
+
+	t.Run("whole field", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"attributes"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.AttributesMask()
+		assert.Nil(t, subMask.GetMaskedFields())
+		assert.Equal(t, attr, subMask.Mask(attr))
+	})
+
+	t.Run("not selected", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku"})
+		assert.Equal(t, nil, err)
+		assert.Nil(t, fm.AttributesMask())
+	})
+
+	t.Run("map of messages", func(t *testing.T) {
+		fm, err := NewCatalogFieldMask([]string{"attributesByCode.{code|options.name}"})
+		assert.Equal(t, nil, err)
+
+		subMask := fm.AttributesByCodeMask()
+		assert.Equal(t, &pb.Attribute{
+			Code:    "ATTR01",
+			Options: []*pb.Option{{Name: "Option Name"}},
+		}, subMask.Mask(attr))
+	})
+}
+
+func TestProductFieldMaskCache(t *testing.T) {
+	var hits, misses int
+	cache := NewProductFieldMaskCache(
+		fields.NewCache[*ProductFieldMask](2, fields.WithCacheMetrics(
+			func() { hits++ },
+			func() { misses++ },
+		)),
+		fields.WithNameStyle(fields.NameStyleBoth),
+	)
+
+	fm1, err := cache.Get([]string{"sku", "provider.{name|image_url}"})
+	assert.Equal(t, nil, err)
+
+	fm2, err := cache.Get([]string{"provider.imageUrl", "provider.name", "sku"})
+	assert.Equal(t, nil, err)
+	assert.Same(t, fm1, fm2)
+	assert.Equal(t, 1, hits)
+	assert.Equal(t, 1, misses)
+
+	assert.Equal(t, &pb.Product{
+		Sku:      "SKU01",
+		Provider: &pb.ProviderInfo{Name: "Provider Name", ImageUrl: "image.png"},
+	}, fm2.Mask(&pb.Product{
+		Sku:      "SKU01",
+		Provider: &pb.ProviderInfo{Id: 21, Name: "Provider Name", ImageUrl: "image.png"},
+	}))
+
+	fm3, err := cache.Get([]string{"sku"})
+	assert.Equal(t, nil, err)
+	assert.NotSame(t, fm1, fm3)
+	assert.Equal(t, 2, misses)
+
+	fm, err := cache.Get([]string{"provider.unknown"})
+	assert.Equal(t, fields.ErrFieldNotFound("provider.unknown"), err)
+	assert.Nil(t, fm)
+	assert.Equal(t, 2, misses)
+}
+
+func TestProductFieldMaskCache_Raw_Masked_Fields(t *testing.T) {
+	var hits, misses int
+	fieldCache := fields.NewCache[*ProductFieldMask](4, fields.WithCacheMetrics(
+		func() { hits++ },
+		func() { misses++ },
+	))
+	cache := NewProductFieldMaskCache(fieldCache)
+
+	fm1, err := cache.Get([]string{"sku", "provider.{name|id}"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, fieldCache.Len())
+
+	// the same raw masked fields are found without parsing
+	fm2, err := cache.Get([]string{"sku", "provider.{name|id}"})
+	assert.Equal(t, nil, err)
+	assert.Same(t, fm1, fm2)
+	assert.Equal(t, 2, fieldCache.Len())
+
+	// the same canonical form is found after parsing, and also cached by the raw masked fields
+	fm3, err := cache.Get([]string{"provider.{id|name}", "sku"})
+	assert.Equal(t, nil, err)
+	assert.Same(t, fm1, fm3)
+	assert.Equal(t, 3, fieldCache.Len())
+
+	assert.Equal(t, 2, hits)
+	assert.Equal(t, 1, misses)
+
+	// the raw masked fields are not split by commas
+	fm, err := cache.Get([]string{"sku,provider.{name|id}"})
+	assert.Equal(t, "fields: character ',' is not allowed", err.Error())
+	assert.Nil(t, fm)
+	assert.Equal(t, 3, fieldCache.Len())
+}
@@ -0,0 +1,1705 @@
+// Code generated by fieldmask; DO NOT EDIT.
+
+package tree
+
+import (
+	"strings"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	pb "github.com/QuangTung97/fieldmask/testdata/recursive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type CategoryFieldMask struct {
+	mask         *pb_Category_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewCategoryFieldMask(maskedFields []string, options ...fields.Option) (*CategoryFieldMask, error) {
+	fieldInfos, err := computeCategoryFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newCategoryFieldMask(fieldInfos, options)
+}
+
+func computeCategoryFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeCategoryFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeCategoryFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Category_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// CategoryFieldMaskCache caches the field masks of NewCategoryFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type CategoryFieldMaskCache struct {
+	cache   *fields.Cache[*CategoryFieldMask]
+	options []fields.Option
+}
+
+// NewCategoryFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewCategoryFieldMaskCache(cache *fields.Cache[*CategoryFieldMask], options ...fields.Option) *CategoryFieldMaskCache {
+	return &CategoryFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewCategoryFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *CategoryFieldMaskCache) Get(maskedFields []string) (*CategoryFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeCategoryFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*CategoryFieldMask, error) {
+		return newCategoryFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewCategoryFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewCategoryFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*CategoryFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeCategoryFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newCategoryFieldMask(fieldInfos, options)
+}
+
+// NewCategoryFieldMaskFromPaths creates a field mask from the paths built by CategoryPaths
+func NewCategoryFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*CategoryFieldMask, error) {
+	return NewCategoryFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewCategoryExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewCategoryExcludeMask(excludedFields []string, options ...fields.Option) (*CategoryFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeCategoryFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Category_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newCategoryFieldMask(fieldInfos, options)
+}
+
+func newCategoryFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*CategoryFieldMask, error) {
+	mask, err := pb_Category_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CategoryFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *CategoryFieldMask) Mask(msg *pb.Category) *pb.Category {
+	newMsg := &pb.Category{}
+	pb_Category_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *CategoryFieldMask) MaskInto(dst *pb.Category, src *pb.Category) {
+	pb_Category_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *CategoryFieldMask) MaskSlice(dst []*pb.Category, src []*pb.Category) []*pb.Category {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Category
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Category{}
+		}
+		pb_Category_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *CategoryFieldMask) MaskInPlace(msg *pb.Category) {
+	pb_Category_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *CategoryFieldMask) Apply(dst *pb.Category, src *pb.Category) {
+	pb_Category_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *CategoryFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *CategoryFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Category_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *CategoryFieldMask) Has(path string) bool {
+	return pb_Category_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *CategoryFieldMask) HasAny(path string) bool {
+	return pb_Category_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *CategoryFieldMask) Selection() pb_Category_Selection {
+	return pb_Category_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *CategoryFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *CategoryFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *CategoryFieldMask) Parent() pb_Category_Selection {
+	return fm.Selection().Parent()
+}
+
+func (fm *CategoryFieldMask) Children() pb_Category_Selection {
+	return fm.Selection().Children()
+}
+
+// ParentMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *CategoryFieldMask) ParentMask() *CategoryFieldMask {
+	if fm.mask == nil {
+		return &CategoryFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "parent")
+	return &CategoryFieldMask{
+		mask:         fm.mask.Parent,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// ChildrenMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *CategoryFieldMask) ChildrenMask() *CategoryFieldMask {
+	if fm.mask == nil {
+		return &CategoryFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<3) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "children")
+	return &CategoryFieldMask{
+		mask:         fm.mask.Children,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type ThreadFieldMask struct {
+	mask         *pb_Thread_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewThreadFieldMask(maskedFields []string, options ...fields.Option) (*ThreadFieldMask, error) {
+	fieldInfos, err := computeThreadFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newThreadFieldMask(fieldInfos, options)
+}
+
+func computeThreadFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeThreadFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeThreadFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Thread_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// ThreadFieldMaskCache caches the field masks of NewThreadFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ThreadFieldMaskCache struct {
+	cache   *fields.Cache[*ThreadFieldMask]
+	options []fields.Option
+}
+
+// NewThreadFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewThreadFieldMaskCache(cache *fields.Cache[*ThreadFieldMask], options ...fields.Option) *ThreadFieldMaskCache {
+	return &ThreadFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewThreadFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ThreadFieldMaskCache) Get(maskedFields []string) (*ThreadFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeThreadFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ThreadFieldMask, error) {
+		return newThreadFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewThreadFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewThreadFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ThreadFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeThreadFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newThreadFieldMask(fieldInfos, options)
+}
+
+// NewThreadFieldMaskFromPaths creates a field mask from the paths built by ThreadPaths
+func NewThreadFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ThreadFieldMask, error) {
+	return NewThreadFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewThreadExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewThreadExcludeMask(excludedFields []string, options ...fields.Option) (*ThreadFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeThreadFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Thread_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newThreadFieldMask(fieldInfos, options)
+}
+
+func newThreadFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ThreadFieldMask, error) {
+	mask, err := pb_Thread_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ThreadFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *ThreadFieldMask) Mask(msg *pb.Thread) *pb.Thread {
+	newMsg := &pb.Thread{}
+	pb_Thread_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ThreadFieldMask) MaskInto(dst *pb.Thread, src *pb.Thread) {
+	pb_Thread_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ThreadFieldMask) MaskSlice(dst []*pb.Thread, src []*pb.Thread) []*pb.Thread {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Thread
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Thread{}
+		}
+		pb_Thread_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ThreadFieldMask) MaskInPlace(msg *pb.Thread) {
+	pb_Thread_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ThreadFieldMask) Apply(dst *pb.Thread, src *pb.Thread) {
+	pb_Thread_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *ThreadFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ThreadFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Thread_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ThreadFieldMask) Has(path string) bool {
+	return pb_Thread_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ThreadFieldMask) HasAny(path string) bool {
+	return pb_Thread_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ThreadFieldMask) Selection() pb_Thread_Selection {
+	return pb_Thread_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ThreadFieldMask) Title() bool {
+	return fm.Selection().Title()
+}
+
+func (fm *ThreadFieldMask) Comments() pb_Comment_Selection {
+	return fm.Selection().Comments()
+}
+
+// CommentsMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ThreadFieldMask) CommentsMask() *CommentFieldMask {
+	if fm.mask == nil {
+		return &CommentFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<1) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "comments")
+	return &CommentFieldMask{
+		mask:         fm.mask.Comments,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type CommentFieldMask struct {
+	mask         *pb_Comment_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *CommentFieldMask) Mask(msg *pb.Comment) *pb.Comment {
+	newMsg := &pb.Comment{}
+	pb_Comment_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *CommentFieldMask) MaskInto(dst *pb.Comment, src *pb.Comment) {
+	pb_Comment_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *CommentFieldMask) MaskSlice(dst []*pb.Comment, src []*pb.Comment) []*pb.Comment {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Comment
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Comment{}
+		}
+		pb_Comment_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *CommentFieldMask) MaskInPlace(msg *pb.Comment) {
+	pb_Comment_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *CommentFieldMask) Apply(dst *pb.Comment, src *pb.Comment) {
+	pb_Comment_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *CommentFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *CommentFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Comment_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *CommentFieldMask) Has(path string) bool {
+	return pb_Comment_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *CommentFieldMask) HasAny(path string) bool {
+	return pb_Comment_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *CommentFieldMask) Selection() pb_Comment_Selection {
+	return pb_Comment_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *CommentFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *CommentFieldMask) Content() bool {
+	return fm.Selection().Content()
+}
+
+func (fm *CommentFieldMask) Replies() pb_Thread_Selection {
+	return fm.Selection().Replies()
+}
+
+// RepliesMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *CommentFieldMask) RepliesMask() *ThreadFieldMask {
+	if fm.mask == nil {
+		return &ThreadFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "replies")
+	return &ThreadFieldMask{
+		mask:         fm.mask.Replies,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// CategoryPaths builds the field paths of pb.Category checked by the compiler
+var CategoryPaths = pb_Category_Paths{}
+
+// ThreadPaths builds the field paths of pb.Thread checked by the compiler
+var ThreadPaths = pb_Thread_Paths{}
+
+// pb_Category_Mask is the compiled form of a list of fields of Category, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Category_Mask struct {
+	bits     [1]uint64
+	Parent   *pb_Category_Mask
+	Children *pb_Category_Mask
+}
+
+var pb_Category_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "name"},
+	{FieldName: "parent"},
+	{FieldName: "children"},
+}
+
+func pb_Category_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Category_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Category_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Category_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		case "parent":
+			isSimpleField = false
+			subMask, err := pb_Category_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "parent")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Parent = subMask
+		case "children":
+			isSimpleField = false
+			subMask, err := pb_Category_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "children")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 3
+			m.Children = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Category_Keep(m *pb_Category_Mask, newMsg *pb.Category, msg *pb.Category) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if msg.Parent != nil {
+			newMsg.Parent = &pb.Category{}
+			pb_Category_Keep(m.Parent, newMsg.Parent, msg.Parent)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := make([]*pb.Category, 0, len(msg.Children))
+		for _, e := range msg.Children {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Category{}
+			pb_Category_Keep(m.Children, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Children = msgList
+	}
+}
+
+// pb_Thread_Mask is the compiled form of a list of fields of Thread, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Thread_Mask struct {
+	bits     [1]uint64
+	Comments *pb_Comment_Mask
+}
+
+var pb_Thread_AllFields = []fields.FieldInfo{
+	{FieldName: "title"},
+	{FieldName: "comments"},
+}
+
+func pb_Thread_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Thread_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Thread_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Thread_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "title":
+			m.bits[0] |= 1 << 0
+		case "comments":
+			isSimpleField = false
+			subMask, err := pb_Comment_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "comments")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 1
+			m.Comments = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Thread_Keep(m *pb_Thread_Mask, newMsg *pb.Thread, msg *pb.Thread) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Title = msg.Title
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		msgList := make([]*pb.Comment, 0, len(msg.Comments))
+		for _, e := range msg.Comments {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Comment{}
+			pb_Comment_Keep(m.Comments, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Comments = msgList
+	}
+}
+
+// pb_Comment_Mask is the compiled form of a list of fields of Comment, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Comment_Mask struct {
+	bits    [1]uint64
+	Replies *pb_Thread_Mask
+}
+
+var pb_Comment_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "content"},
+	{FieldName: "replies"},
+}
+
+func pb_Comment_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Comment_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Comment_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Comment_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "content":
+			m.bits[0] |= 1 << 1
+		case "replies":
+			isSimpleField = false
+			subMask, err := pb_Thread_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "replies")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Replies = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Comment_Keep(m *pb_Comment_Mask, newMsg *pb.Comment, msg *pb.Comment) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Content = msg.Content
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if msg.Replies != nil {
+			newMsg.Replies = &pb.Thread{}
+			pb_Thread_Keep(m.Replies, newMsg.Replies, msg.Replies)
+		}
+	}
+}
+
+// pb_Category_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Category_AllMask = &pb_Category_Mask{bits: [1]uint64{0xf}}
+
+func pb_Category_KeepInto(m *pb_Category_Mask, dst *pb.Category, src *pb.Category) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Category_AllMask
+	}
+
+	srcId := src.Id
+	srcName := src.Name
+	srcParent := src.Parent
+	oldParent := dst.Parent
+	srcChildren := src.Children
+	oldChildren := dst.Children
+
+	*dst = pb.Category{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if srcParent != nil {
+			subMsg := oldParent
+			if subMsg == nil {
+				subMsg = &pb.Category{}
+			}
+			pb_Category_KeepInto(m.Parent, subMsg, srcParent)
+			dst.Parent = subMsg
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := oldChildren[:0]
+		for i, e := range srcChildren {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Category
+			if i < cap(oldChildren) {
+				subMsg = oldChildren[:cap(oldChildren)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Category{}
+			}
+			pb_Category_KeepInto(m.Children, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Children = msgList
+	}
+}
+
+// pb_Thread_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Thread_AllMask = &pb_Thread_Mask{bits: [1]uint64{0x3}}
+
+func pb_Thread_KeepInto(m *pb_Thread_Mask, dst *pb.Thread, src *pb.Thread) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Thread_AllMask
+	}
+
+	srcTitle := src.Title
+	srcComments := src.Comments
+	oldComments := dst.Comments
+
+	*dst = pb.Thread{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Title = srcTitle
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		msgList := oldComments[:0]
+		for i, e := range srcComments {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Comment
+			if i < cap(oldComments) {
+				subMsg = oldComments[:cap(oldComments)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Comment{}
+			}
+			pb_Comment_KeepInto(m.Comments, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Comments = msgList
+	}
+}
+
+// pb_Comment_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Comment_AllMask = &pb_Comment_Mask{bits: [1]uint64{0x7}}
+
+func pb_Comment_KeepInto(m *pb_Comment_Mask, dst *pb.Comment, src *pb.Comment) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Comment_AllMask
+	}
+
+	srcId := src.Id
+	srcContent := src.Content
+	srcReplies := src.Replies
+	oldReplies := dst.Replies
+
+	*dst = pb.Comment{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Content = srcContent
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if srcReplies != nil {
+			subMsg := oldReplies
+			if subMsg == nil {
+				subMsg = &pb.Thread{}
+			}
+			pb_Thread_KeepInto(m.Replies, subMsg, srcReplies)
+			dst.Replies = subMsg
+		}
+	}
+}
+
+// pb_Category_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Category_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Category_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "parent":
+			field.SubFields = pb_Category_ToProtoNames(field.SubFields)
+		case "children":
+			field.SubFields = pb_Category_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Thread_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Thread_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Thread_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "comments":
+			field.SubFields = pb_Comment_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Comment_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Comment_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Comment_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "replies":
+			field.SubFields = pb_Thread_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+func pb_Category_Apply(m *pb_Category_Mask, dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Category_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Category_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Category_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Parent == nil {
+			pb_Category_Apply_Parent(dst, src, opts)
+		} else {
+			pb_Category_ApplySub_Parent(m.Parent, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if m.Children == nil {
+			pb_Category_Apply_Children(dst, src, opts)
+		} else {
+			pb_Category_ApplySub_Children(m.Children, dst, src, opts)
+		}
+	}
+}
+
+func pb_Category_ApplyAll(dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	pb_Category_Apply_Id(dst, src, opts)
+	pb_Category_Apply_Name(dst, src, opts)
+	pb_Category_Apply_Parent(dst, src, opts)
+	pb_Category_Apply_Children(dst, src, opts)
+}
+
+func pb_Thread_Apply(m *pb_Thread_Mask, dst *pb.Thread, src *pb.Thread, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Thread_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Thread_Apply_Title(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if m.Comments == nil {
+			pb_Thread_Apply_Comments(dst, src, opts)
+		} else {
+			pb_Thread_ApplySub_Comments(m.Comments, dst, src, opts)
+		}
+	}
+}
+
+func pb_Thread_ApplyAll(dst *pb.Thread, src *pb.Thread, opts fields.ApplyOptions) {
+	pb_Thread_Apply_Title(dst, src, opts)
+	pb_Thread_Apply_Comments(dst, src, opts)
+}
+
+func pb_Comment_Apply(m *pb_Comment_Mask, dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Comment_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Comment_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Comment_Apply_Content(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Replies == nil {
+			pb_Comment_Apply_Replies(dst, src, opts)
+		} else {
+			pb_Comment_ApplySub_Replies(m.Replies, dst, src, opts)
+		}
+	}
+}
+
+func pb_Comment_ApplyAll(dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	pb_Comment_Apply_Id(dst, src, opts)
+	pb_Comment_Apply_Content(dst, src, opts)
+	pb_Comment_Apply_Replies(dst, src, opts)
+}
+
+func pb_Category_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Category_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "name":
+			name = "name"
+		case "parent":
+			name = "parent"
+		case "children":
+			name = "children"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["parent"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "parent"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Category_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "parent")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "parent", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["children"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "children"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Category_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "children")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "children", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Thread_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Thread_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "title":
+			name = "title"
+		case "comments":
+			name = "comments"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 2)
+	if subFields, ok := excluded["title"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "title"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "title")
+	}
+	if subFields, ok := excluded["comments"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "comments"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Comment_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "comments")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "comments", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Comment_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Comment_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "content":
+			name = "content"
+		case "replies":
+			name = "replies"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 3)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["content"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "content"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "content")
+	}
+	if subFields, ok := excluded["replies"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "replies"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Thread_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "replies")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "replies", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Category_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "parent", "parent"):
+			subFields, err := pb_Category_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "parent"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "children", "children"):
+			subFields, err := pb_Category_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "children"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Thread_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "title", "title"):
+			field.FieldName = "title"
+		case style.Match(field.FieldName, "comments", "comments"):
+			subFields, err := pb_Comment_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "comments"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Comment_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "content", "content"):
+			field.FieldName = "content"
+		case style.Match(field.FieldName, "replies", "replies"):
+			subFields, err := pb_Thread_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "replies"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// =========================================
+// Category Apply Functions
+// =========================================
+
+func pb_Category_Apply_Id(dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_Category_Apply_Name(dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_Category_Apply_Parent(dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Parent == nil {
+		dst.Parent = src.Parent
+		return
+	}
+	if dst.Parent == nil {
+		dst.Parent = &pb.Category{}
+	}
+	pb_Category_ApplyAll(dst.Parent, src.Parent, opts)
+}
+
+func pb_Category_Apply_Children(dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Children = append(dst.Children, src.Children...)
+		return
+	}
+	dst.Children = src.Children
+}
+
+func pb_Category_ApplySub_Parent(m *pb_Category_Mask, dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	if dst.Parent == nil && src.Parent == nil {
+		return
+	}
+	if dst.Parent == nil {
+		dst.Parent = &pb.Category{}
+	}
+	srcSubMsg := src.Parent
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.Category{}
+	}
+	pb_Category_Apply(m, dst.Parent, srcSubMsg, opts)
+}
+
+func pb_Category_ApplySub_Children(m *pb_Category_Mask, dst *pb.Category, src *pb.Category, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Category, 0, len(src.Children))
+	for _, e := range src.Children {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Category{}
+		pb_Category_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Children = append(dst.Children, msgList...)
+		return
+	}
+	dst.Children = msgList
+}
+
+// =========================================
+// Thread Apply Functions
+// =========================================
+
+func pb_Thread_Apply_Title(dst *pb.Thread, src *pb.Thread, opts fields.ApplyOptions) {
+	dst.Title = src.Title
+}
+
+func pb_Thread_Apply_Comments(dst *pb.Thread, src *pb.Thread, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Comments = append(dst.Comments, src.Comments...)
+		return
+	}
+	dst.Comments = src.Comments
+}
+
+func pb_Thread_ApplySub_Comments(m *pb_Comment_Mask, dst *pb.Thread, src *pb.Thread, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Comment, 0, len(src.Comments))
+	for _, e := range src.Comments {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Comment{}
+		pb_Comment_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Comments = append(dst.Comments, msgList...)
+		return
+	}
+	dst.Comments = msgList
+}
+
+// =========================================
+// Comment Apply Functions
+// =========================================
+
+func pb_Comment_Apply_Id(dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_Comment_Apply_Content(dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	dst.Content = src.Content
+}
+
+func pb_Comment_Apply_Replies(dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Replies == nil {
+		dst.Replies = src.Replies
+		return
+	}
+	if dst.Replies == nil {
+		dst.Replies = &pb.Thread{}
+	}
+	pb_Thread_ApplyAll(dst.Replies, src.Replies, opts)
+}
+
+func pb_Comment_ApplySub_Replies(m *pb_Thread_Mask, dst *pb.Comment, src *pb.Comment, opts fields.ApplyOptions) {
+	if dst.Replies == nil && src.Replies == nil {
+		return
+	}
+	if dst.Replies == nil {
+		dst.Replies = &pb.Thread{}
+	}
+	srcSubMsg := src.Replies
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.Thread{}
+	}
+	pb_Thread_Apply(m, dst.Replies, srcSubMsg, opts)
+}
+
+// pb_Category_Paths builds the field paths of the sub fields of Category
+type pb_Category_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Category_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Category_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_Category_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_Category_Paths) Parent() pb_Category_Paths {
+	return pb_Category_Paths{path: p.path.Append("parent")}
+}
+
+func (p pb_Category_Paths) Children() pb_Category_Paths {
+	return pb_Category_Paths{path: p.path.Append("children")}
+}
+
+// pb_Thread_Paths builds the field paths of the sub fields of Thread
+type pb_Thread_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Thread_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Thread_Paths) Title() fields.Path {
+	return p.path.Append("title")
+}
+
+func (p pb_Thread_Paths) Comments() pb_Comment_Paths {
+	return pb_Comment_Paths{path: p.path.Append("comments")}
+}
+
+// pb_Comment_Paths builds the field paths of the sub fields of Comment
+type pb_Comment_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Comment_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Comment_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_Comment_Paths) Content() fields.Path {
+	return p.path.Append("content")
+}
+
+func (p pb_Comment_Paths) Replies() pb_Thread_Paths {
+	return pb_Thread_Paths{path: p.path.Append("replies")}
+}
+
+// pb_Category_Selection queries whether the fields of Category are selected
+type pb_Category_Selection struct {
+	selected bool
+	mask     *pb_Category_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Category_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Category_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Category_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Category_Selection) Parent() pb_Category_Selection {
+	if s.mask == nil {
+		return pb_Category_Selection{selected: s.selected}
+	}
+	return pb_Category_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Parent}
+}
+
+func (s pb_Category_Selection) Children() pb_Category_Selection {
+	if s.mask == nil {
+		return pb_Category_Selection{selected: s.selected}
+	}
+	return pb_Category_Selection{selected: s.mask.bits[0]&(1<<3) != 0, mask: s.mask.Children}
+}
+
+func pb_Category_HasPath(m *pb_Category_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "parent":
+		if m == nil {
+			return len(path) == 1 || pb_Category_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Parent == nil
+		}
+		return pb_Category_HasPath(m.Parent, path[1:], partial)
+	case "children":
+		if m == nil {
+			return len(path) == 1 || pb_Category_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<3) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Children == nil
+		}
+		return pb_Category_HasPath(m.Children, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Thread_Selection queries whether the fields of Thread are selected
+type pb_Thread_Selection struct {
+	selected bool
+	mask     *pb_Thread_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Thread_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Thread_Selection) Title() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Thread_Selection) Comments() pb_Comment_Selection {
+	if s.mask == nil {
+		return pb_Comment_Selection{selected: s.selected}
+	}
+	return pb_Comment_Selection{selected: s.mask.bits[0]&(1<<1) != 0, mask: s.mask.Comments}
+}
+
+func pb_Thread_HasPath(m *pb_Thread_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "title":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "comments":
+		if m == nil {
+			return len(path) == 1 || pb_Comment_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<1) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Comments == nil
+		}
+		return pb_Comment_HasPath(m.Comments, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Comment_Selection queries whether the fields of Comment are selected
+type pb_Comment_Selection struct {
+	selected bool
+	mask     *pb_Comment_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Comment_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Comment_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Comment_Selection) Content() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Comment_Selection) Replies() pb_Thread_Selection {
+	if s.mask == nil {
+		return pb_Thread_Selection{selected: s.selected}
+	}
+	return pb_Thread_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Replies}
+}
+
+func pb_Comment_HasPath(m *pb_Comment_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "content":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "replies":
+		if m == nil {
+			return len(path) == 1 || pb_Thread_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Replies == nil
+		}
+		return pb_Thread_HasPath(m.Replies, path[1:], partial)
+	default:
+		return false
+	}
+}
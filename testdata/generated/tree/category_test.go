@@ -0,0 +1,160 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/recursive"
+)
+
+func newCategoryTree() *recursive.Category {
+	return &recursive.Category{
+		Id:   1,
+		Name: "Root",
+		Children: []*recursive.Category{
+			{
+				Id:   11,
+				Name: "Child 11",
+				Children: []*recursive.Category{
+					{Id: 111, Name: "Child 111"},
+				},
+			},
+			{
+				Id:   12,
+				Name: "Child 12",
+			},
+		},
+	}
+}
+
+func TestCategoryFieldMask(t *testing.T) {
+	t.Run("nested children", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"name", "children.{id|children.name}"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &recursive.Category{
+			Name: "Root",
+			Children: []*recursive.Category{
+				{
+					Id: 11,
+					Children: []*recursive.Category{
+						{Name: "Child 111"},
+					},
+				},
+				{
+					Id:       12,
+					Children: []*recursive.Category{},
+				},
+			},
+		}, fm.Mask(newCategoryTree()))
+	})
+
+	t.Run("whole children", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"children"})
+		assert.Equal(t, nil, err)
+
+		tree := newCategoryTree()
+		assert.Equal(t, &recursive.Category{
+			Children: tree.Children,
+		}, fm.Mask(tree))
+	})
+
+	t.Run("mask in place", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"children.children.id"})
+		assert.Equal(t, nil, err)
+
+		tree := newCategoryTree()
+		fm.MaskInPlace(tree)
+		assert.Equal(t, &recursive.Category{
+			Children: []*recursive.Category{
+				{
+					Children: []*recursive.Category{
+						{Id: 111},
+					},
+				},
+				{},
+			},
+		}, tree)
+	})
+
+	t.Run("exceeded max depth", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"children.children.name"}, fields.WithMaxFieldDepth(2))
+		assert.Equal(t, fields.ErrExceedMaxDepth, err)
+		assert.Nil(t, fm)
+
+		fm, err = NewCategoryFieldMask([]string{"children.children.name"}, fields.WithMaxFieldDepth(3))
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, fm)
+	})
+
+	t.Run("apply parent", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"parent.name"})
+		assert.Equal(t, nil, err)
+
+		dst := &recursive.Category{
+			Name:   "Dst",
+			Parent: &recursive.Category{Id: 5, Name: "Old Parent"},
+		}
+		fm.Apply(dst, &recursive.Category{
+			Parent: &recursive.Category{Id: 6, Name: "New Parent"},
+		})
+		assert.Equal(t, &recursive.Category{
+			Name:   "Dst",
+			Parent: &recursive.Category{Id: 5, Name: "New Parent"},
+		}, dst)
+	})
+
+	t.Run("children mask", func(t *testing.T) {
+		fm, err := NewCategoryFieldMask([]string{"name", "children.{id|children.name}"})
+		assert.Equal(t, nil, err)
+
+		childrenMask := fm.ChildrenMask()
+		assert.Equal(t, []fields.FieldInfo{
+			{FieldName: "id"},
+			{FieldName: "children", SubFields: []fields.FieldInfo{{FieldName: "name"}}},
+		}, childrenMask.GetMaskedFields())
+		assert.Equal(t, []*recursive.Category{
+			{Id: 11, Children: []*recursive.Category{{Name: "Child 111"}}},
+			{Id: 12},
+		}, childrenMask.MaskSlice(nil, newCategoryTree().Children))
+
+		assert.Equal(t, []fields.FieldInfo{{FieldName: "name"}}, childrenMask.ChildrenMask().GetMaskedFields())
+		assert.Nil(t, fm.ParentMask())
+	})
+}
+
+func TestThreadFieldMask(t *testing.T) {
+	fm, err := NewThreadFieldMask([]string{"comments.{content|replies.comments.id}"})
+	assert.Equal(t, nil, err)
+
+	thread := &recursive.Thread{
+		Title: "Thread",
+		Comments: []*recursive.Comment{
+			{
+				Id:      1,
+				Content: "Comment 1",
+				Replies: &recursive.Thread{
+					Title: "Replies",
+					Comments: []*recursive.Comment{
+						{Id: 2, Content: "Comment 2"},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, &recursive.Thread{
+		Comments: []*recursive.Comment{
+			{
+				Content: "Comment 1",
+				Replies: &recursive.Thread{
+					Comments: []*recursive.Comment{
+						{Id: 2},
+					},
+				},
+			},
+		},
+	}, fm.Mask(thread))
+}
@@ -0,0 +1,3961 @@
+// Code generated by fieldmask; DO NOT EDIT.
+
+package wellknown
+
+import (
+	"strings"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	pb2 "github.com/QuangTung97/fieldmask/testdata/document"
+	pb "github.com/QuangTung97/fieldmask/testdata/pb"
+	pb1 "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type ProductFieldMask struct {
+	mask         *pb_Product_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewProductFieldMask(maskedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	fieldInfos, err := computeProductFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
+
+func computeProductFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeProductFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeProductFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb_Product_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// ProductFieldMaskCache caches the field masks of NewProductFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type ProductFieldMaskCache struct {
+	cache   *fields.Cache[*ProductFieldMask]
+	options []fields.Option
+}
+
+// NewProductFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewProductFieldMaskCache(cache *fields.Cache[*ProductFieldMask], options ...fields.Option) *ProductFieldMaskCache {
+	return &ProductFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewProductFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *ProductFieldMaskCache) Get(maskedFields []string) (*ProductFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeProductFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*ProductFieldMask, error) {
+		return newProductFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewProductFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewProductFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*ProductFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeProductFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
+
+// NewProductFieldMaskFromPaths creates a field mask from the paths built by ProductPaths
+func NewProductFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*ProductFieldMask, error) {
+	return NewProductFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewProductExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewProductExcludeMask(excludedFields []string, options ...fields.Option) (*ProductFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeProductFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb_Product_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newProductFieldMask(fieldInfos, options)
+}
+
+func newProductFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*ProductFieldMask, error) {
+	mask, err := pb_Product_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *ProductFieldMask) Mask(msg *pb.Product) *pb.Product {
+	newMsg := &pb.Product{}
+	pb_Product_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProductFieldMask) MaskInto(dst *pb.Product, src *pb.Product) {
+	pb_Product_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProductFieldMask) MaskSlice(dst []*pb.Product, src []*pb.Product) []*pb.Product {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Product
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Product{}
+		}
+		pb_Product_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProductFieldMask) MaskInPlace(msg *pb.Product) {
+	pb_Product_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProductFieldMask) Apply(dst *pb.Product, src *pb.Product) {
+	pb_Product_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *ProductFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProductFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Product_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProductFieldMask) Has(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProductFieldMask) HasAny(path string) bool {
+	return pb_Product_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProductFieldMask) Selection() pb_Product_Selection {
+	return pb_Product_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ProductFieldMask) Sku() bool {
+	return fm.Selection().Sku()
+}
+
+func (fm *ProductFieldMask) Provider() pb_ProviderInfo_Selection {
+	return fm.Selection().Provider()
+}
+
+func (fm *ProductFieldMask) Attributes() pb_Attribute_Selection {
+	return fm.Selection().Attributes()
+}
+
+func (fm *ProductFieldMask) SellerIds() bool {
+	return fm.Selection().SellerIds()
+}
+
+func (fm *ProductFieldMask) BrandCodes() bool {
+	return fm.Selection().BrandCodes()
+}
+
+func (fm *ProductFieldMask) CreatedAt() pb1_Timestamp_Selection {
+	return fm.Selection().CreatedAt()
+}
+
+func (fm *ProductFieldMask) Quantity() pb1_DoubleValue_Selection {
+	return fm.Selection().Quantity()
+}
+
+func (fm *ProductFieldMask) Stocks() pb1_Int32Value_Selection {
+	return fm.Selection().Stocks()
+}
+
+// ProviderMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) ProviderMask() *ProviderInfoFieldMask {
+	if fm.mask == nil {
+		return &ProviderInfoFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<1) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "provider")
+	return &ProviderInfoFieldMask{
+		mask:         fm.mask.Provider,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// AttributesMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) AttributesMask() *AttributeFieldMask {
+	if fm.mask == nil {
+		return &AttributeFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<2) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "attributes")
+	return &AttributeFieldMask{
+		mask:         fm.mask.Attributes,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// CreatedAtMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) CreatedAtMask() *TimestampFieldMask {
+	if fm.mask == nil {
+		return &TimestampFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<5) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "createdAt")
+	return &TimestampFieldMask{
+		mask:         fm.mask.CreatedAt,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// QuantityMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) QuantityMask() *DoubleValueFieldMask {
+	if fm.mask == nil {
+		return &DoubleValueFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<6) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "quantity")
+	return &DoubleValueFieldMask{
+		mask:         fm.mask.Quantity,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+// StocksMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *ProductFieldMask) StocksMask() *Int32ValueFieldMask {
+	if fm.mask == nil {
+		return &Int32ValueFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<7) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "stocks")
+	return &Int32ValueFieldMask{
+		mask:         fm.mask.Stocks,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type ProviderInfoFieldMask struct {
+	mask         *pb_ProviderInfo_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *ProviderInfoFieldMask) Mask(msg *pb.ProviderInfo) *pb.ProviderInfo {
+	newMsg := &pb.ProviderInfo{}
+	pb_ProviderInfo_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *ProviderInfoFieldMask) MaskInto(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *ProviderInfoFieldMask) MaskSlice(dst []*pb.ProviderInfo, src []*pb.ProviderInfo) []*pb.ProviderInfo {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.ProviderInfo
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.ProviderInfo{}
+		}
+		pb_ProviderInfo_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *ProviderInfoFieldMask) MaskInPlace(msg *pb.ProviderInfo) {
+	pb_ProviderInfo_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *ProviderInfoFieldMask) Apply(dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	pb_ProviderInfo_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *ProviderInfoFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *ProviderInfoFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_ProviderInfo_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *ProviderInfoFieldMask) Has(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *ProviderInfoFieldMask) HasAny(path string) bool {
+	return pb_ProviderInfo_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *ProviderInfoFieldMask) Selection() pb_ProviderInfo_Selection {
+	return pb_ProviderInfo_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *ProviderInfoFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *ProviderInfoFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *ProviderInfoFieldMask) Logo() bool {
+	return fm.Selection().Logo()
+}
+
+func (fm *ProviderInfoFieldMask) ImageUrl() bool {
+	return fm.Selection().ImageUrl()
+}
+
+type AttributeFieldMask struct {
+	mask         *pb_Attribute_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *AttributeFieldMask) Mask(msg *pb.Attribute) *pb.Attribute {
+	newMsg := &pb.Attribute{}
+	pb_Attribute_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *AttributeFieldMask) MaskInto(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *AttributeFieldMask) MaskSlice(dst []*pb.Attribute, src []*pb.Attribute) []*pb.Attribute {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Attribute
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Attribute{}
+		}
+		pb_Attribute_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *AttributeFieldMask) MaskInPlace(msg *pb.Attribute) {
+	pb_Attribute_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *AttributeFieldMask) Apply(dst *pb.Attribute, src *pb.Attribute) {
+	pb_Attribute_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *AttributeFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *AttributeFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Attribute_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *AttributeFieldMask) Has(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *AttributeFieldMask) HasAny(path string) bool {
+	return pb_Attribute_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *AttributeFieldMask) Selection() pb_Attribute_Selection {
+	return pb_Attribute_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *AttributeFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *AttributeFieldMask) Code() bool {
+	return fm.Selection().Code()
+}
+
+func (fm *AttributeFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+func (fm *AttributeFieldMask) Options() pb_Option_Selection {
+	return fm.Selection().Options()
+}
+
+// OptionsMask returns the field mask of the selected sub fields, nil when the field is not selected
+func (fm *AttributeFieldMask) OptionsMask() *OptionFieldMask {
+	if fm.mask == nil {
+		return &OptionFieldMask{applyOptions: fm.applyOptions}
+	}
+	if fm.mask.bits[0]&(1<<3) == 0 {
+		return nil
+	}
+	maskedFields, _ := fields.SubTree(fm.maskedFields, "options")
+	return &OptionFieldMask{
+		mask:         fm.mask.Options,
+		applyOptions: fm.applyOptions,
+		maskedFields: maskedFields,
+	}
+}
+
+type OptionFieldMask struct {
+	mask         *pb_Option_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *OptionFieldMask) Mask(msg *pb.Option) *pb.Option {
+	newMsg := &pb.Option{}
+	pb_Option_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *OptionFieldMask) MaskInto(dst *pb.Option, src *pb.Option) {
+	pb_Option_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *OptionFieldMask) MaskSlice(dst []*pb.Option, src []*pb.Option) []*pb.Option {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb.Option
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb.Option{}
+		}
+		pb_Option_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *OptionFieldMask) MaskInPlace(msg *pb.Option) {
+	pb_Option_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *OptionFieldMask) Apply(dst *pb.Option, src *pb.Option) {
+	pb_Option_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *OptionFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *OptionFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb_Option_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *OptionFieldMask) Has(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *OptionFieldMask) HasAny(path string) bool {
+	return pb_Option_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *OptionFieldMask) Selection() pb_Option_Selection {
+	return pb_Option_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *OptionFieldMask) Code() bool {
+	return fm.Selection().Code()
+}
+
+func (fm *OptionFieldMask) Name() bool {
+	return fm.Selection().Name()
+}
+
+type TimestampFieldMask struct {
+	mask         *pb1_Timestamp_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *TimestampFieldMask) Mask(msg *pb1.Timestamp) *pb1.Timestamp {
+	newMsg := &pb1.Timestamp{}
+	pb1_Timestamp_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *TimestampFieldMask) MaskInto(dst *pb1.Timestamp, src *pb1.Timestamp) {
+	pb1_Timestamp_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *TimestampFieldMask) MaskSlice(dst []*pb1.Timestamp, src []*pb1.Timestamp) []*pb1.Timestamp {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb1.Timestamp
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb1.Timestamp{}
+		}
+		pb1_Timestamp_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *TimestampFieldMask) MaskInPlace(msg *pb1.Timestamp) {
+	pb1_Timestamp_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *TimestampFieldMask) Apply(dst *pb1.Timestamp, src *pb1.Timestamp) {
+	pb1_Timestamp_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *TimestampFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *TimestampFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb1_Timestamp_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *TimestampFieldMask) Has(path string) bool {
+	return pb1_Timestamp_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *TimestampFieldMask) HasAny(path string) bool {
+	return pb1_Timestamp_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *TimestampFieldMask) Selection() pb1_Timestamp_Selection {
+	return pb1_Timestamp_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *TimestampFieldMask) Seconds() bool {
+	return fm.Selection().Seconds()
+}
+
+func (fm *TimestampFieldMask) Nanos() bool {
+	return fm.Selection().Nanos()
+}
+
+type DoubleValueFieldMask struct {
+	mask         *pb1_DoubleValue_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *DoubleValueFieldMask) Mask(msg *pb1.DoubleValue) *pb1.DoubleValue {
+	newMsg := &pb1.DoubleValue{}
+	pb1_DoubleValue_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *DoubleValueFieldMask) MaskInto(dst *pb1.DoubleValue, src *pb1.DoubleValue) {
+	pb1_DoubleValue_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *DoubleValueFieldMask) MaskSlice(dst []*pb1.DoubleValue, src []*pb1.DoubleValue) []*pb1.DoubleValue {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb1.DoubleValue
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb1.DoubleValue{}
+		}
+		pb1_DoubleValue_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *DoubleValueFieldMask) MaskInPlace(msg *pb1.DoubleValue) {
+	pb1_DoubleValue_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *DoubleValueFieldMask) Apply(dst *pb1.DoubleValue, src *pb1.DoubleValue) {
+	pb1_DoubleValue_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *DoubleValueFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *DoubleValueFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb1_DoubleValue_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *DoubleValueFieldMask) Has(path string) bool {
+	return pb1_DoubleValue_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *DoubleValueFieldMask) HasAny(path string) bool {
+	return pb1_DoubleValue_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *DoubleValueFieldMask) Selection() pb1_DoubleValue_Selection {
+	return pb1_DoubleValue_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *DoubleValueFieldMask) Value() bool {
+	return fm.Selection().Value()
+}
+
+type Int32ValueFieldMask struct {
+	mask         *pb1_Int32Value_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func (fm *Int32ValueFieldMask) Mask(msg *pb1.Int32Value) *pb1.Int32Value {
+	newMsg := &pb1.Int32Value{}
+	pb1_Int32Value_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *Int32ValueFieldMask) MaskInto(dst *pb1.Int32Value, src *pb1.Int32Value) {
+	pb1_Int32Value_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *Int32ValueFieldMask) MaskSlice(dst []*pb1.Int32Value, src []*pb1.Int32Value) []*pb1.Int32Value {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb1.Int32Value
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb1.Int32Value{}
+		}
+		pb1_Int32Value_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *Int32ValueFieldMask) MaskInPlace(msg *pb1.Int32Value) {
+	pb1_Int32Value_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *Int32ValueFieldMask) Apply(dst *pb1.Int32Value, src *pb1.Int32Value) {
+	pb1_Int32Value_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *Int32ValueFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *Int32ValueFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb1_Int32Value_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *Int32ValueFieldMask) Has(path string) bool {
+	return pb1_Int32Value_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *Int32ValueFieldMask) HasAny(path string) bool {
+	return pb1_Int32Value_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *Int32ValueFieldMask) Selection() pb1_Int32Value_Selection {
+	return pb1_Int32Value_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *Int32ValueFieldMask) Value() bool {
+	return fm.Selection().Value()
+}
+
+type DocumentFieldMask struct {
+	mask         *pb2_Document_Mask
+	applyOptions fields.ApplyOptions
+	maskedFields []fields.FieldInfo
+}
+
+func NewDocumentFieldMask(maskedFields []string, options ...fields.Option) (*DocumentFieldMask, error) {
+	fieldInfos, err := computeDocumentFieldMaskInfos(maskedFields, options)
+	if err != nil {
+		return nil, err
+	}
+	return newDocumentFieldMask(fieldInfos, options)
+}
+
+func computeDocumentFieldMaskInfos(maskedFields []string, options []fields.Option) ([]fields.FieldInfo, error) {
+	fieldInfos, err := fields.ComputeFieldInfos(maskedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeDocumentFieldMaskInfos(fieldInfos, options)
+}
+
+func normalizeDocumentFieldMaskInfos(fieldInfos []fields.FieldInfo, options []fields.Option) ([]fields.FieldInfo, error) {
+	style := fields.ComputeNameStyle(options...)
+	fieldInfos, err := pb2_Document_NormalizeFieldNames(fieldInfos, style)
+	if err != nil {
+		return nil, err
+	}
+	if style == fields.NameStyleJSON {
+		// already validated by fields.ComputeFieldInfos or fields.FromFieldMask
+		return fieldInfos, nil
+	}
+	if err := fields.ValidateLimitedToFields(fieldInfos, options...); err != nil {
+		return nil, err
+	}
+	return fieldInfos, nil
+}
+
+// DocumentFieldMaskCache caches the field masks of NewDocumentFieldMask by the raw masked fields,
+// and by their canonical form. The field masks returned by Get are shared and must not be modified
+type DocumentFieldMaskCache struct {
+	cache   *fields.Cache[*DocumentFieldMask]
+	options []fields.Option
+}
+
+// NewDocumentFieldMaskCache creates a cache storing the field masks in cache, created with options.
+// cache must not be used by other caches with different options
+func NewDocumentFieldMaskCache(cache *fields.Cache[*DocumentFieldMask], options ...fields.Option) *DocumentFieldMaskCache {
+	return &DocumentFieldMaskCache{
+		cache:   cache,
+		options: options,
+	}
+}
+
+// Get is the same as NewDocumentFieldMask, but skips parsing when the same masked fields are cached,
+// and only compiles the field mask when the canonical form is not cached
+func (c *DocumentFieldMaskCache) Get(maskedFields []string) (*DocumentFieldMask, error) {
+	var fieldInfos []fields.FieldInfo
+	return c.cache.GetNormalized(fields.RawCacheKey(maskedFields), func() (string, error) {
+		var err error
+		fieldInfos, err = computeDocumentFieldMaskInfos(maskedFields, c.options)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields.FormatCompact(fieldInfos), ","), nil
+	}, func() (*DocumentFieldMask, error) {
+		return newDocumentFieldMask(fieldInfos, c.options)
+	})
+}
+
+// NewDocumentFieldMaskFromProto creates a field mask from the plain dot paths of a google.protobuf.FieldMask,
+// accepting both the proto names and the JSON names by default. The paths are merged as in fields.FromFieldMask
+func NewDocumentFieldMaskFromProto(fieldMask *fieldmaskpb.FieldMask, options ...fields.Option) (*DocumentFieldMask, error) {
+	options = append([]fields.Option{fields.WithNameStyle(fields.NameStyleBoth)}, options...)
+	fieldInfos, err := fields.FromFieldMask(fieldMask, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err = normalizeDocumentFieldMaskInfos(fieldInfos, options)
+	if err != nil {
+		return nil, err
+	}
+	return newDocumentFieldMask(fieldInfos, options)
+}
+
+// NewDocumentFieldMaskFromPaths creates a field mask from the paths built by DocumentPaths
+func NewDocumentFieldMaskFromPaths(paths []fields.Path, options ...fields.Option) (*DocumentFieldMask, error) {
+	return NewDocumentFieldMask(fields.PathStrings(paths...), options...)
+}
+
+// NewDocumentExcludeMask creates a field mask of all fields except the excluded fields.
+// Returns fields.ErrExcludeAllFields when all fields are excluded
+func NewDocumentExcludeMask(excludedFields []string, options ...fields.Option) (*DocumentFieldMask, error) {
+	excludedInfos, err := fields.ComputeFieldInfos(excludedFields, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	excludedInfos, err = normalizeDocumentFieldMaskInfos(excludedInfos, options)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos, err := pb2_Document_ComputeIncludedFields(excludedInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldInfos) == 0 {
+		return nil, fields.ErrExcludeAllFields
+	}
+	return newDocumentFieldMask(fieldInfos, options)
+}
+
+func newDocumentFieldMask(fieldInfos []fields.FieldInfo, options []fields.Option) (*DocumentFieldMask, error) {
+	mask, err := pb2_Document_ComputeMask(fieldInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DocumentFieldMask{
+		mask:         mask,
+		applyOptions: fields.ComputeApplyOptions(options...),
+		maskedFields: fieldInfos,
+	}, nil
+}
+
+func (fm *DocumentFieldMask) Mask(msg *pb2.Document) *pb2.Document {
+	newMsg := &pb2.Document{}
+	pb2_Document_Keep(fm.mask, newMsg, msg)
+	return newMsg
+}
+
+// MaskInto is the same as Mask, but writes the result into dst, reusing its sub messages and slices.
+// dst must not share any sub messages or slices with src, except when dst is src.
+// The sub messages of src are copied, so the later calls reusing dst do not modify src
+func (fm *DocumentFieldMask) MaskInto(dst *pb2.Document, src *pb2.Document) {
+	pb2_Document_KeepInto(fm.mask, dst, src)
+}
+
+// MaskSlice masks each message of src into dst, reusing messages in the capacity of dst, and returns the result slice
+func (fm *DocumentFieldMask) MaskSlice(dst []*pb2.Document, src []*pb2.Document) []*pb2.Document {
+	result := dst[:0]
+	for i, msg := range src {
+		if msg == nil {
+			result = append(result, nil)
+			continue
+		}
+		var newMsg *pb2.Document
+		if i < cap(dst) {
+			newMsg = dst[:cap(dst)][i]
+		}
+		if newMsg == nil {
+			newMsg = &pb2.Document{}
+		}
+		pb2_Document_KeepInto(fm.mask, newMsg, msg)
+		result = append(result, newMsg)
+	}
+	return result
+}
+
+// MaskInPlace clears all fields of msg that are not in the field mask
+func (fm *DocumentFieldMask) MaskInPlace(msg *pb2.Document) {
+	pb2_Document_KeepInto(fm.mask, msg, msg)
+}
+
+// Apply copies the fields of the field mask from src to dst, with the options of the field mask.
+// The fields replaced as a whole are assigned from src, so dst shares their sub messages, slices and maps with src.
+// Clone src with proto.Clone before, when dst must be modified independently of src
+func (fm *DocumentFieldMask) Apply(dst *pb2.Document, src *pb2.Document) {
+	pb2_Document_Apply(fm.mask, dst, src, fm.applyOptions)
+}
+
+func (fm *DocumentFieldMask) GetMaskedFields() []fields.FieldInfo {
+	return fm.maskedFields
+}
+
+// ToProto converts the masked fields to a google.protobuf.FieldMask with the proto names of the fields.
+// An empty list of masked fields (all fields) is converted to a FieldMask without paths
+func (fm *DocumentFieldMask) ToProto() *fieldmaskpb.FieldMask {
+	return fields.ToFieldMask(pb2_Document_ToProtoNames(fm.maskedFields))
+}
+
+// Has checks whether the field at path (field names separated by '.') is selected as a whole,
+// directly or by one of its ancestors
+func (fm *DocumentFieldMask) Has(path string) bool {
+	return pb2_Document_HasPath(fm.mask, strings.Split(path, "."), false)
+}
+
+// HasAny checks whether the field at path, or any of its sub fields, is selected
+func (fm *DocumentFieldMask) HasAny(path string) bool {
+	return pb2_Document_HasPath(fm.mask, strings.Split(path, "."), true)
+}
+
+// Selection returns the type-safe queries of the selected fields
+func (fm *DocumentFieldMask) Selection() pb2_Document_Selection {
+	return pb2_Document_Selection{selected: true, mask: fm.mask}
+}
+
+func (fm *DocumentFieldMask) Id() bool {
+	return fm.Selection().Id()
+}
+
+func (fm *DocumentFieldMask) Metadata() bool {
+	return fm.Selection().Metadata()
+}
+
+func (fm *DocumentFieldMask) Extra() bool {
+	return fm.Selection().Extra()
+}
+
+func (fm *DocumentFieldMask) Tags() bool {
+	return fm.Selection().Tags()
+}
+
+func (fm *DocumentFieldMask) History() bool {
+	return fm.Selection().History()
+}
+
+// ProductPaths builds the field paths of pb.Product checked by the compiler
+var ProductPaths = pb_Product_Paths{}
+
+// DocumentPaths builds the field paths of pb2.Document checked by the compiler
+var DocumentPaths = pb2_Document_Paths{}
+
+// pb_Product_Mask is the compiled form of a list of fields of Product, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Product_Mask struct {
+	bits       [1]uint64
+	Provider   *pb_ProviderInfo_Mask
+	Attributes *pb_Attribute_Mask
+	CreatedAt  *pb1_Timestamp_Mask
+	Quantity   *pb1_DoubleValue_Mask
+	Stocks     *pb1_Int32Value_Mask
+}
+
+var pb_Product_AllFields = []fields.FieldInfo{
+	{FieldName: "sku"},
+	{FieldName: "provider"},
+	{FieldName: "attributes"},
+	{FieldName: "sellerIds"},
+	{FieldName: "brandCodes"},
+	{FieldName: "createdAt"},
+	{FieldName: "quantity"},
+	{FieldName: "stocks"},
+}
+
+func pb_Product_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Product_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Product_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "sku":
+			m.bits[0] |= 1 << 0
+		case "provider":
+			isSimpleField = false
+			subMask, err := pb_ProviderInfo_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "provider")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 1
+			m.Provider = subMask
+		case "attributes":
+			isSimpleField = false
+			subMask, err := pb_Attribute_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "attributes")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 2
+			m.Attributes = subMask
+		case "sellerIds", "seller_ids":
+			m.bits[0] |= 1 << 3
+		case "brandCodes", "brand_codes":
+			m.bits[0] |= 1 << 4
+		case "createdAt", "created_at":
+			isSimpleField = false
+			subMask, err := pb1_Timestamp_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "createdAt")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 5
+			m.CreatedAt = subMask
+		case "quantity":
+			isSimpleField = false
+			subMask, err := pb1_DoubleValue_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "quantity")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 6
+			m.Quantity = subMask
+		case "stocks":
+			isSimpleField = false
+			subMask, err := pb1_Int32Value_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "stocks")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 7
+			m.Stocks = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Product_Keep(m *pb_Product_Mask, newMsg *pb.Product, msg *pb.Product) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Sku = msg.Sku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if msg.Provider != nil {
+			newMsg.Provider = &pb.ProviderInfo{}
+			pb_ProviderInfo_Keep(m.Provider, newMsg.Provider, msg.Provider)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := make([]*pb.Attribute, 0, len(msg.Attributes))
+		for _, e := range msg.Attributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Attribute{}
+			pb_Attribute_Keep(m.Attributes, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.SellerIds = msg.SellerIds
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		newMsg.BrandCodes = msg.BrandCodes
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		if msg.CreatedAt != nil {
+			newMsg.CreatedAt = &pb1.Timestamp{}
+			pb1_Timestamp_Keep(m.CreatedAt, newMsg.CreatedAt, msg.CreatedAt)
+		}
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		if msg.Quantity != nil {
+			newMsg.Quantity = &pb1.DoubleValue{}
+			pb1_DoubleValue_Keep(m.Quantity, newMsg.Quantity, msg.Quantity)
+		}
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		msgList := make([]*pb1.Int32Value, 0, len(msg.Stocks))
+		for _, e := range msg.Stocks {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb1.Int32Value{}
+			pb1_Int32Value_Keep(m.Stocks, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Stocks = msgList
+	}
+}
+
+// pb_ProviderInfo_Mask is the compiled form of a list of fields of ProviderInfo, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_ProviderInfo_Mask struct {
+	bits [1]uint64
+}
+
+var pb_ProviderInfo_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "name"},
+	{FieldName: "logo"},
+	{FieldName: "imageUrl"},
+}
+
+func pb_ProviderInfo_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_ProviderInfo_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_ProviderInfo_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		case "logo":
+			m.bits[0] |= 1 << 2
+		case "imageUrl", "image_url":
+			m.bits[0] |= 1 << 3
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_ProviderInfo_Keep(m *pb_ProviderInfo_Mask, newMsg *pb.ProviderInfo, msg *pb.ProviderInfo) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Logo = msg.Logo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.ImageUrl = msg.ImageUrl
+	}
+}
+
+// pb_Attribute_Mask is the compiled form of a list of fields of Attribute, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Attribute_Mask struct {
+	bits    [1]uint64
+	Options *pb_Option_Mask
+}
+
+var pb_Attribute_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "code"},
+	{FieldName: "name"},
+	{FieldName: "options"},
+}
+
+func pb_Attribute_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Attribute_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Attribute_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "code":
+			m.bits[0] |= 1 << 1
+		case "name":
+			m.bits[0] |= 1 << 2
+		case "options":
+			isSimpleField = false
+			subMask, err := pb_Option_ComputeMask(field.SubFields)
+			if err != nil {
+				return nil, fields.PrependParentField(err, "options")
+			}
+			if fields.HasWildcard(field.SubFields) {
+				subMask = nil
+			}
+			m.bits[0] |= 1 << 3
+			m.Options = subMask
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Attribute_Keep(m *pb_Attribute_Mask, newMsg *pb.Attribute, msg *pb.Attribute) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Code = msg.Code
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Name = msg.Name
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := make([]*pb.Option, 0, len(msg.Options))
+		for _, e := range msg.Options {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			newSubMsg := &pb.Option{}
+			pb_Option_Keep(m.Options, newSubMsg, e)
+			msgList = append(msgList, newSubMsg)
+		}
+		newMsg.Options = msgList
+	}
+}
+
+// pb_Option_Mask is the compiled form of a list of fields of Option, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb_Option_Mask struct {
+	bits [1]uint64
+}
+
+var pb_Option_AllFields = []fields.FieldInfo{
+	{FieldName: "code"},
+	{FieldName: "name"},
+}
+
+func pb_Option_ComputeMask(fieldInfos []fields.FieldInfo) (*pb_Option_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb_Option_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "code":
+			m.bits[0] |= 1 << 0
+		case "name":
+			m.bits[0] |= 1 << 1
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb_Option_Keep(m *pb_Option_Mask, newMsg *pb.Option, msg *pb.Option) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Code = msg.Code
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Name = msg.Name
+	}
+}
+
+// pb1_Timestamp_Mask is the compiled form of a list of fields of Timestamp, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb1_Timestamp_Mask struct {
+	bits [1]uint64
+}
+
+var pb1_Timestamp_AllFields = []fields.FieldInfo{
+	{FieldName: "seconds"},
+	{FieldName: "nanos"},
+}
+
+func pb1_Timestamp_ComputeMask(fieldInfos []fields.FieldInfo) (*pb1_Timestamp_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_Timestamp_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb1_Timestamp_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "seconds":
+			m.bits[0] |= 1 << 0
+		case "nanos":
+			m.bits[0] |= 1 << 1
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb1_Timestamp_Keep(m *pb1_Timestamp_Mask, newMsg *pb1.Timestamp, msg *pb1.Timestamp) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Seconds = msg.Seconds
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Nanos = msg.Nanos
+	}
+}
+
+// pb1_DoubleValue_Mask is the compiled form of a list of fields of DoubleValue, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb1_DoubleValue_Mask struct {
+	bits [1]uint64
+}
+
+var pb1_DoubleValue_AllFields = []fields.FieldInfo{
+	{FieldName: "value"},
+}
+
+func pb1_DoubleValue_ComputeMask(fieldInfos []fields.FieldInfo) (*pb1_DoubleValue_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_DoubleValue_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb1_DoubleValue_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "value":
+			m.bits[0] |= 1 << 0
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb1_DoubleValue_Keep(m *pb1_DoubleValue_Mask, newMsg *pb1.DoubleValue, msg *pb1.DoubleValue) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Value = msg.Value
+	}
+}
+
+// pb1_Int32Value_Mask is the compiled form of a list of fields of Int32Value, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb1_Int32Value_Mask struct {
+	bits [1]uint64
+}
+
+var pb1_Int32Value_AllFields = []fields.FieldInfo{
+	{FieldName: "value"},
+}
+
+func pb1_Int32Value_ComputeMask(fieldInfos []fields.FieldInfo) (*pb1_Int32Value_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_Int32Value_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb1_Int32Value_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "value":
+			m.bits[0] |= 1 << 0
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb1_Int32Value_Keep(m *pb1_Int32Value_Mask, newMsg *pb1.Int32Value, msg *pb1.Int32Value) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Value = msg.Value
+	}
+}
+
+// pb2_Document_Mask is the compiled form of a list of fields of Document, nil means all fields.
+// A nil sub mask of a selected field means all fields of that field
+type pb2_Document_Mask struct {
+	bits     [1]uint64
+	Metadata []fields.FieldInfo
+	Extra    []fields.FieldInfo
+	Tags     []fields.FieldInfo
+}
+
+var pb2_Document_AllFields = []fields.FieldInfo{
+	{FieldName: "id"},
+	{FieldName: "metadata"},
+	{FieldName: "extra"},
+	{FieldName: "tags"},
+	{FieldName: "history"},
+	{FieldName: "toProto"},
+}
+
+func pb2_Document_ComputeMask(fieldInfos []fields.FieldInfo) (*pb2_Document_Mask, error) {
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb2_Document_AllFields)
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	m := &pb2_Document_Mask{}
+
+	for _, field := range fieldInfos {
+		isSimpleField := true
+
+		switch field.FieldName {
+		case "id":
+			m.bits[0] |= 1 << 0
+		case "metadata":
+			isSimpleField = false
+			m.bits[0] |= 1 << 1
+			m.Metadata = field.SubFields
+		case "extra":
+			isSimpleField = false
+			m.bits[0] |= 1 << 2
+			m.Extra = field.SubFields
+		case "tags":
+			isSimpleField = false
+			m.bits[0] |= 1 << 3
+			m.Tags = field.SubFields
+		case "history":
+			m.bits[0] |= 1 << 4
+		case "toProto", "to_proto":
+			m.bits[0] |= 1 << 5
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		if isSimpleField && len(field.SubFields) > 0 {
+			return nil, fields.PrependParentField(fields.ErrFieldNotFound(field.SubFields[0].FieldName), field.FieldName)
+		}
+	}
+
+	return m, nil
+}
+
+func pb2_Document_Keep(m *pb2_Document_Mask, newMsg *pb2.Document, msg *pb2.Document) {
+	if m == nil {
+		*newMsg = *msg
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		newMsg.Id = msg.Id
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		newMsg.Metadata = pb1_Struct_KeepKeys(m.Metadata, msg.Metadata)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		newMsg.Extra = pb1_Value_KeepKeys(m.Extra, msg.Extra)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		newMsg.Tags = pb1_ListValue_KeepKeys(m.Tags, msg.Tags)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		newMsg.History = msg.History
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		newMsg.ToProto = msg.ToProto
+	}
+}
+
+// pb_Product_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Product_AllMask = &pb_Product_Mask{bits: [1]uint64{0xff}}
+
+func pb_Product_KeepInto(m *pb_Product_Mask, dst *pb.Product, src *pb.Product) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Product_AllMask
+	}
+
+	srcSku := src.Sku
+	srcProvider := src.Provider
+	oldProvider := dst.Provider
+	srcAttributes := src.Attributes
+	oldAttributes := dst.Attributes
+	srcSellerIds := src.SellerIds
+	srcBrandCodes := src.BrandCodes
+	srcCreatedAt := src.CreatedAt
+	oldCreatedAt := dst.CreatedAt
+	srcQuantity := src.Quantity
+	oldQuantity := dst.Quantity
+	srcStocks := src.Stocks
+	oldStocks := dst.Stocks
+
+	*dst = pb.Product{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Sku = srcSku
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if srcProvider != nil {
+			subMsg := oldProvider
+			if subMsg == nil {
+				subMsg = &pb.ProviderInfo{}
+			}
+			pb_ProviderInfo_KeepInto(m.Provider, subMsg, srcProvider)
+			dst.Provider = subMsg
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		msgList := oldAttributes[:0]
+		for i, e := range srcAttributes {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Attribute
+			if i < cap(oldAttributes) {
+				subMsg = oldAttributes[:cap(oldAttributes)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Attribute{}
+			}
+			pb_Attribute_KeepInto(m.Attributes, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Attributes = msgList
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.SellerIds = srcSellerIds
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		dst.BrandCodes = srcBrandCodes
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		if srcCreatedAt != nil {
+			subMsg := oldCreatedAt
+			if subMsg == nil {
+				subMsg = &pb1.Timestamp{}
+			}
+			pb1_Timestamp_KeepInto(m.CreatedAt, subMsg, srcCreatedAt)
+			dst.CreatedAt = subMsg
+		}
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		if srcQuantity != nil {
+			subMsg := oldQuantity
+			if subMsg == nil {
+				subMsg = &pb1.DoubleValue{}
+			}
+			pb1_DoubleValue_KeepInto(m.Quantity, subMsg, srcQuantity)
+			dst.Quantity = subMsg
+		}
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		msgList := oldStocks[:0]
+		for i, e := range srcStocks {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb1.Int32Value
+			if i < cap(oldStocks) {
+				subMsg = oldStocks[:cap(oldStocks)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb1.Int32Value{}
+			}
+			pb1_Int32Value_KeepInto(m.Stocks, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Stocks = msgList
+	}
+}
+
+// pb_ProviderInfo_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_ProviderInfo_AllMask = &pb_ProviderInfo_Mask{bits: [1]uint64{0xf}}
+
+func pb_ProviderInfo_KeepInto(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_ProviderInfo_AllMask
+	}
+
+	srcId := src.Id
+	srcName := src.Name
+	srcLogo := src.Logo
+	srcImageUrl := src.ImageUrl
+
+	*dst = pb.ProviderInfo{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Logo = srcLogo
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.ImageUrl = srcImageUrl
+	}
+}
+
+// pb_Attribute_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Attribute_AllMask = &pb_Attribute_Mask{bits: [1]uint64{0xf}}
+
+func pb_Attribute_KeepInto(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Attribute_AllMask
+	}
+
+	srcId := src.Id
+	srcCode := src.Code
+	srcName := src.Name
+	srcOptions := src.Options
+	oldOptions := dst.Options
+
+	*dst = pb.Attribute{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Code = srcCode
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Name = srcName
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		msgList := oldOptions[:0]
+		for i, e := range srcOptions {
+			if e == nil {
+				msgList = append(msgList, nil)
+				continue
+			}
+			var subMsg *pb.Option
+			if i < cap(oldOptions) {
+				subMsg = oldOptions[:cap(oldOptions)][i]
+			}
+			if subMsg == nil {
+				subMsg = &pb.Option{}
+			}
+			pb_Option_KeepInto(m.Options, subMsg, e)
+			msgList = append(msgList, subMsg)
+		}
+		dst.Options = msgList
+	}
+}
+
+// pb_Option_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb_Option_AllMask = &pb_Option_Mask{bits: [1]uint64{0x3}}
+
+func pb_Option_KeepInto(m *pb_Option_Mask, dst *pb.Option, src *pb.Option) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb_Option_AllMask
+	}
+
+	srcCode := src.Code
+	srcName := src.Name
+
+	*dst = pb.Option{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Code = srcCode
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Name = srcName
+	}
+}
+
+// pb1_Timestamp_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb1_Timestamp_AllMask = &pb1_Timestamp_Mask{bits: [1]uint64{0x3}}
+
+func pb1_Timestamp_KeepInto(m *pb1_Timestamp_Mask, dst *pb1.Timestamp, src *pb1.Timestamp) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb1_Timestamp_AllMask
+	}
+
+	srcSeconds := src.Seconds
+	srcNanos := src.Nanos
+
+	*dst = pb1.Timestamp{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Seconds = srcSeconds
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Nanos = srcNanos
+	}
+}
+
+// pb1_DoubleValue_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb1_DoubleValue_AllMask = &pb1_DoubleValue_Mask{bits: [1]uint64{0x1}}
+
+func pb1_DoubleValue_KeepInto(m *pb1_DoubleValue_Mask, dst *pb1.DoubleValue, src *pb1.DoubleValue) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb1_DoubleValue_AllMask
+	}
+
+	srcValue := src.Value
+
+	*dst = pb1.DoubleValue{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Value = srcValue
+	}
+}
+
+// pb1_Int32Value_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb1_Int32Value_AllMask = &pb1_Int32Value_Mask{bits: [1]uint64{0x1}}
+
+func pb1_Int32Value_KeepInto(m *pb1_Int32Value_Mask, dst *pb1.Int32Value, src *pb1.Int32Value) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb1_Int32Value_AllMask
+	}
+
+	srcValue := src.Value
+
+	*dst = pb1.Int32Value{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Value = srcValue
+	}
+}
+
+// pb2_Document_AllMask selects all fields, for copying the whole message without sharing the sub messages of src
+var pb2_Document_AllMask = &pb2_Document_Mask{bits: [1]uint64{0x3f}}
+
+func pb2_Document_KeepInto(m *pb2_Document_Mask, dst *pb2.Document, src *pb2.Document) {
+	if m == nil {
+		if dst == src {
+			return
+		}
+		// the sub messages of dst are reused by the later calls, so they must not be the ones of src
+		m = pb2_Document_AllMask
+	}
+
+	srcId := src.Id
+	srcMetadata := src.Metadata
+	srcExtra := src.Extra
+	srcTags := src.Tags
+	srcHistory := src.History
+	srcToProto := src.ToProto
+
+	*dst = pb2.Document{}
+	if m.bits[0]&(1<<0) != 0 {
+		dst.Id = srcId
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		dst.Metadata = pb1_Struct_KeepKeys(m.Metadata, srcMetadata)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		dst.Extra = pb1_Value_KeepKeys(m.Extra, srcExtra)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		dst.Tags = pb1_ListValue_KeepKeys(m.Tags, srcTags)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		dst.History = srcHistory
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		dst.ToProto = srcToProto
+	}
+}
+
+// pb_Product_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Product_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Product_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "provider":
+			field.SubFields = pb_ProviderInfo_ToProtoNames(field.SubFields)
+		case "attributes":
+			field.SubFields = pb_Attribute_ToProtoNames(field.SubFields)
+		case "sellerIds":
+			field.FieldName = "seller_ids"
+		case "brandCodes":
+			field.FieldName = "brand_codes"
+		case "createdAt":
+			field.FieldName = "created_at"
+			field.SubFields = pb1_Timestamp_ToProtoNames(field.SubFields)
+		case "quantity":
+			field.SubFields = pb1_DoubleValue_ToProtoNames(field.SubFields)
+		case "stocks":
+			field.SubFields = pb1_Int32Value_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_ProviderInfo_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_ProviderInfo_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_ProviderInfo_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "imageUrl":
+			field.FieldName = "image_url"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Attribute_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Attribute_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Attribute_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "options":
+			field.SubFields = pb_Option_ToProtoNames(field.SubFields)
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// pb_Option_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb_Option_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb_Option_AllFields)
+	return fieldInfos
+}
+
+// pb1_Timestamp_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb1_Timestamp_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_Timestamp_AllFields)
+	return fieldInfos
+}
+
+// pb1_DoubleValue_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb1_DoubleValue_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_DoubleValue_AllFields)
+	return fieldInfos
+}
+
+// pb1_Int32Value_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb1_Int32Value_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb1_Int32Value_AllFields)
+	return fieldInfos
+}
+
+// pb2_Document_ToProtoNames converts the JSON names of the field infos to the proto names, expanding the wildcard
+func pb2_Document_ToProtoNames(fieldInfos []fields.FieldInfo) []fields.FieldInfo {
+	if len(fieldInfos) == 0 {
+		return nil
+	}
+	fieldInfos = fields.ExpandWildcard(fieldInfos, pb2_Document_AllFields)
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch field.FieldName {
+		case "toProto":
+			field.FieldName = "to_proto"
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+func pb_Product_Apply(m *pb_Product_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Product_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Product_Apply_Sku(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if m.Provider == nil {
+			pb_Product_Apply_Provider(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Provider(m.Provider, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Attributes == nil {
+			pb_Product_Apply_Attributes(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Attributes(m.Attributes, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_Product_Apply_SellerIds(dst, src, opts)
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		pb_Product_Apply_BrandCodes(dst, src, opts)
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		if m.CreatedAt == nil {
+			pb_Product_Apply_CreatedAt(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_CreatedAt(m.CreatedAt, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<6) != 0 {
+		if m.Quantity == nil {
+			pb_Product_Apply_Quantity(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Quantity(m.Quantity, dst, src, opts)
+		}
+	}
+	if m.bits[0]&(1<<7) != 0 {
+		if m.Stocks == nil {
+			pb_Product_Apply_Stocks(dst, src, opts)
+		} else {
+			pb_Product_ApplySub_Stocks(m.Stocks, dst, src, opts)
+		}
+	}
+}
+
+func pb_Product_ApplyAll(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	pb_Product_Apply_Sku(dst, src, opts)
+	pb_Product_Apply_Provider(dst, src, opts)
+	pb_Product_Apply_Attributes(dst, src, opts)
+	pb_Product_Apply_SellerIds(dst, src, opts)
+	pb_Product_Apply_BrandCodes(dst, src, opts)
+	pb_Product_Apply_CreatedAt(dst, src, opts)
+	pb_Product_Apply_Quantity(dst, src, opts)
+	pb_Product_Apply_Stocks(dst, src, opts)
+}
+
+func pb_ProviderInfo_Apply(m *pb_ProviderInfo_Mask, dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_ProviderInfo_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_ProviderInfo_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_ProviderInfo_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+	}
+}
+
+func pb_ProviderInfo_ApplyAll(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	pb_ProviderInfo_Apply_Id(dst, src, opts)
+	pb_ProviderInfo_Apply_Name(dst, src, opts)
+	pb_ProviderInfo_Apply_Logo(dst, src, opts)
+	pb_ProviderInfo_Apply_ImageUrl(dst, src, opts)
+}
+
+func pb_Attribute_Apply(m *pb_Attribute_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Attribute_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Attribute_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Attribute_Apply_Code(dst, src, opts)
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		pb_Attribute_Apply_Name(dst, src, opts)
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if m.Options == nil {
+			pb_Attribute_Apply_Options(dst, src, opts)
+		} else {
+			pb_Attribute_ApplySub_Options(m.Options, dst, src, opts)
+		}
+	}
+}
+
+func pb_Attribute_ApplyAll(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	pb_Attribute_Apply_Id(dst, src, opts)
+	pb_Attribute_Apply_Code(dst, src, opts)
+	pb_Attribute_Apply_Name(dst, src, opts)
+	pb_Attribute_Apply_Options(dst, src, opts)
+}
+
+func pb_Option_Apply(m *pb_Option_Mask, dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	if m == nil {
+		pb_Option_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb_Option_Apply_Code(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb_Option_Apply_Name(dst, src, opts)
+	}
+}
+
+func pb_Option_ApplyAll(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	pb_Option_Apply_Code(dst, src, opts)
+	pb_Option_Apply_Name(dst, src, opts)
+}
+
+func pb1_Timestamp_Apply(m *pb1_Timestamp_Mask, dst *pb1.Timestamp, src *pb1.Timestamp, opts fields.ApplyOptions) {
+	if m == nil {
+		pb1_Timestamp_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb1_Timestamp_Apply_Seconds(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		pb1_Timestamp_Apply_Nanos(dst, src, opts)
+	}
+}
+
+func pb1_Timestamp_ApplyAll(dst *pb1.Timestamp, src *pb1.Timestamp, opts fields.ApplyOptions) {
+	pb1_Timestamp_Apply_Seconds(dst, src, opts)
+	pb1_Timestamp_Apply_Nanos(dst, src, opts)
+}
+
+func pb1_DoubleValue_Apply(m *pb1_DoubleValue_Mask, dst *pb1.DoubleValue, src *pb1.DoubleValue, opts fields.ApplyOptions) {
+	if m == nil {
+		pb1_DoubleValue_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb1_DoubleValue_Apply_Value(dst, src, opts)
+	}
+}
+
+func pb1_DoubleValue_ApplyAll(dst *pb1.DoubleValue, src *pb1.DoubleValue, opts fields.ApplyOptions) {
+	pb1_DoubleValue_Apply_Value(dst, src, opts)
+}
+
+func pb1_Int32Value_Apply(m *pb1_Int32Value_Mask, dst *pb1.Int32Value, src *pb1.Int32Value, opts fields.ApplyOptions) {
+	if m == nil {
+		pb1_Int32Value_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb1_Int32Value_Apply_Value(dst, src, opts)
+	}
+}
+
+func pb1_Int32Value_ApplyAll(dst *pb1.Int32Value, src *pb1.Int32Value, opts fields.ApplyOptions) {
+	pb1_Int32Value_Apply_Value(dst, src, opts)
+}
+
+func pb2_Document_Apply(m *pb2_Document_Mask, dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	if m == nil {
+		pb2_Document_ApplyAll(dst, src, opts)
+		return
+	}
+	if m.bits[0]&(1<<0) != 0 {
+		pb2_Document_Apply_Id(dst, src, opts)
+	}
+	if m.bits[0]&(1<<1) != 0 {
+		if m.Metadata == nil {
+			pb2_Document_Apply_Metadata(dst, src, opts)
+		} else {
+			dst.Metadata = pb1_Struct_ApplyKeys(m.Metadata, dst.Metadata, src.Metadata)
+		}
+	}
+	if m.bits[0]&(1<<2) != 0 {
+		if m.Extra == nil {
+			pb2_Document_Apply_Extra(dst, src, opts)
+		} else {
+			dst.Extra = pb1_Value_ApplyKeys(m.Extra, dst.Extra, src.Extra)
+		}
+	}
+	if m.bits[0]&(1<<3) != 0 {
+		if m.Tags == nil {
+			pb2_Document_Apply_Tags(dst, src, opts)
+		} else {
+			dst.Tags = pb1_ListValue_KeepKeys(m.Tags, src.Tags)
+		}
+	}
+	if m.bits[0]&(1<<4) != 0 {
+		pb2_Document_Apply_History(dst, src, opts)
+	}
+	if m.bits[0]&(1<<5) != 0 {
+		pb2_Document_Apply_ToProto(dst, src, opts)
+	}
+}
+
+func pb2_Document_ApplyAll(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	pb2_Document_Apply_Id(dst, src, opts)
+	pb2_Document_Apply_Metadata(dst, src, opts)
+	pb2_Document_Apply_Extra(dst, src, opts)
+	pb2_Document_Apply_Tags(dst, src, opts)
+	pb2_Document_Apply_History(dst, src, opts)
+	pb2_Document_Apply_ToProto(dst, src, opts)
+}
+
+func pb_Product_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Product_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "sku":
+			name = "sku"
+		case "provider":
+			name = "provider"
+		case "attributes":
+			name = "attributes"
+		case "sellerIds", "seller_ids":
+			name = "sellerIds"
+		case "brandCodes", "brand_codes":
+			name = "brandCodes"
+		case "createdAt", "created_at":
+			name = "createdAt"
+		case "quantity":
+			name = "quantity"
+		case "stocks":
+			name = "stocks"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 8)
+	if subFields, ok := excluded["sku"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "sku"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "sku")
+	}
+	if subFields, ok := excluded["provider"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "provider"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_ProviderInfo_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "provider")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "provider", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["attributes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "attributes"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Attribute_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "attributes")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "attributes", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["sellerIds"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "sellerIds"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "sellerIds")
+	}
+	if subFields, ok := excluded["brandCodes"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "brandCodes"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "brandCodes")
+	}
+	if subFields, ok := excluded["createdAt"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "createdAt"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb1_Timestamp_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "createdAt")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "createdAt", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["quantity"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "quantity"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb1_DoubleValue_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "quantity")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "quantity", SubFields: subIncluded})
+		}
+	}
+	if subFields, ok := excluded["stocks"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "stocks"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb1_Int32Value_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "stocks")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "stocks", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_ProviderInfo_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_ProviderInfo_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "name":
+			name = "name"
+		case "logo":
+			name = "logo"
+		case "imageUrl", "image_url":
+			name = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["logo"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "logo"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "logo")
+	}
+	if subFields, ok := excluded["imageUrl"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "imageUrl"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "imageUrl")
+	}
+	return result, nil
+}
+
+func pb_Attribute_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Attribute_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "code":
+			name = "code"
+		case "name":
+			name = "name"
+		case "options":
+			name = "options"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 4)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	if subFields, ok := excluded["options"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "options"})
+	} else if len(subFields) > 0 {
+		subIncluded, err := pb_Option_ComputeIncludedFields(subFields)
+		if err != nil {
+			return nil, fields.PrependParentField(err, "options")
+		}
+		if len(subIncluded) > 0 {
+			result = append(result, fields.FieldInfo{FieldName: "options", SubFields: subIncluded})
+		}
+	}
+	return result, nil
+}
+
+func pb_Option_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb_Option_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "code":
+			name = "code"
+		case "name":
+			name = "name"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 2)
+	if subFields, ok := excluded["code"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "code"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "code")
+	}
+	if subFields, ok := excluded["name"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "name"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "name")
+	}
+	return result, nil
+}
+
+func pb1_Timestamp_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb1_Timestamp_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "seconds":
+			name = "seconds"
+		case "nanos":
+			name = "nanos"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 2)
+	if subFields, ok := excluded["seconds"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "seconds"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "seconds")
+	}
+	if subFields, ok := excluded["nanos"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "nanos"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "nanos")
+	}
+	return result, nil
+}
+
+func pb1_DoubleValue_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb1_DoubleValue_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "value":
+			name = "value"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 1)
+	if subFields, ok := excluded["value"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "value"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "value")
+	}
+	return result, nil
+}
+
+func pb1_Int32Value_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb1_Int32Value_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "value":
+			name = "value"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 1)
+	if subFields, ok := excluded["value"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "value"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "value")
+	}
+	return result, nil
+}
+
+func pb2_Document_ComputeIncludedFields(excludedInfos []fields.FieldInfo) ([]fields.FieldInfo, error) {
+	excludedInfos = fields.ExpandWildcard(excludedInfos, pb2_Document_AllFields)
+	excluded := make(map[string][]fields.FieldInfo, len(excludedInfos))
+	for _, field := range excludedInfos {
+		var name string
+		switch field.FieldName {
+		case "id":
+			name = "id"
+		case "metadata":
+			name = "metadata"
+		case "extra":
+			name = "extra"
+		case "tags":
+			name = "tags"
+		case "history":
+			name = "history"
+		case "toProto", "to_proto":
+			name = "toProto"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+		excluded[name] = field.SubFields
+	}
+
+	result := make([]fields.FieldInfo, 0, 6)
+	if subFields, ok := excluded["id"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "id"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "id")
+	}
+	if subFields, ok := excluded["metadata"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "metadata"})
+	} else if len(subFields) > 0 {
+		return nil, fields.ErrSubtractFromWholeField("metadata")
+	}
+	if subFields, ok := excluded["extra"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "extra"})
+	} else if len(subFields) > 0 {
+		return nil, fields.ErrSubtractFromWholeField("extra")
+	}
+	if subFields, ok := excluded["tags"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "tags"})
+	} else if len(subFields) > 0 {
+		return nil, fields.ErrSubtractFromWholeField("tags")
+	}
+	if subFields, ok := excluded["history"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "history"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "history")
+	}
+	if subFields, ok := excluded["toProto"]; !ok {
+		result = append(result, fields.FieldInfo{FieldName: "toProto"})
+	} else if len(subFields) > 0 {
+		return nil, fields.PrependParentField(fields.ErrFieldNotFound(subFields[0].FieldName), "toProto")
+	}
+	return result, nil
+}
+
+func pb_Product_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "sku", "sku"):
+			field.FieldName = "sku"
+		case style.Match(field.FieldName, "provider", "provider"):
+			subFields, err := pb_ProviderInfo_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "provider"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "attributes", "attributes"):
+			subFields, err := pb_Attribute_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "attributes"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "sellerIds", "seller_ids"):
+			field.FieldName = "sellerIds"
+		case style.Match(field.FieldName, "brandCodes", "brand_codes"):
+			field.FieldName = "brandCodes"
+		case style.Match(field.FieldName, "createdAt", "created_at"):
+			subFields, err := pb1_Timestamp_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "createdAt"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "quantity", "quantity"):
+			subFields, err := pb1_DoubleValue_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "quantity"
+			field.SubFields = subFields
+		case style.Match(field.FieldName, "stocks", "stocks"):
+			subFields, err := pb1_Int32Value_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "stocks"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_ProviderInfo_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "logo", "logo"):
+			field.FieldName = "logo"
+		case style.Match(field.FieldName, "imageUrl", "image_url"):
+			field.FieldName = "imageUrl"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Attribute_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		case style.Match(field.FieldName, "options", "options"):
+			subFields, err := pb_Option_NormalizeFieldNames(field.SubFields, style)
+			if err != nil {
+				return nil, fields.PrependParentField(err, field.FieldName)
+			}
+			field.FieldName = "options"
+			field.SubFields = subFields
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb_Option_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "code", "code"):
+			field.FieldName = "code"
+		case style.Match(field.FieldName, "name", "name"):
+			field.FieldName = "name"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb1_Timestamp_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "seconds", "seconds"):
+			field.FieldName = "seconds"
+		case style.Match(field.FieldName, "nanos", "nanos"):
+			field.FieldName = "nanos"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb1_DoubleValue_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "value", "value"):
+			field.FieldName = "value"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb1_Int32Value_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "value", "value"):
+			field.FieldName = "value"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func pb2_Document_NormalizeFieldNames(fieldInfos []fields.FieldInfo, style fields.NameStyle) ([]fields.FieldInfo, error) {
+	if len(fieldInfos) == 0 {
+		return nil, nil
+	}
+
+	result := make([]fields.FieldInfo, 0, len(fieldInfos))
+	for _, field := range fieldInfos {
+		switch {
+		case field.FieldName == fields.Wildcard:
+		case style.Match(field.FieldName, "id", "id"):
+			field.FieldName = "id"
+		case style.Match(field.FieldName, "metadata", "metadata"):
+			field.FieldName = "metadata"
+		case style.Match(field.FieldName, "extra", "extra"):
+			field.FieldName = "extra"
+		case style.Match(field.FieldName, "tags", "tags"):
+			field.FieldName = "tags"
+		case style.Match(field.FieldName, "history", "history"):
+			field.FieldName = "history"
+		case style.Match(field.FieldName, "toProto", "to_proto"):
+			field.FieldName = "toProto"
+		default:
+			return nil, fields.ErrFieldNotFound(field.FieldName)
+		}
+
+		for _, existed := range result {
+			if existed.FieldName == field.FieldName {
+				return nil, fields.ErrDuplicatedField(field.FieldName)
+			}
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// =========================================
+// Product Apply Functions
+// =========================================
+
+func pb_Product_Apply_Sku(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	dst.Sku = src.Sku
+}
+
+func pb_Product_Apply_Provider(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Provider == nil {
+		dst.Provider = src.Provider
+		return
+	}
+	if dst.Provider == nil {
+		dst.Provider = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_ApplyAll(dst.Provider, src.Provider, opts)
+}
+
+func pb_Product_Apply_Attributes(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, src.Attributes...)
+		return
+	}
+	dst.Attributes = src.Attributes
+}
+
+func pb_Product_Apply_SellerIds(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.SellerIds = append(dst.SellerIds, src.SellerIds...)
+		return
+	}
+	dst.SellerIds = src.SellerIds
+}
+
+func pb_Product_Apply_BrandCodes(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.BrandCodes = append(dst.BrandCodes, src.BrandCodes...)
+		return
+	}
+	dst.BrandCodes = src.BrandCodes
+}
+
+func pb_Product_Apply_CreatedAt(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.CreatedAt == nil {
+		dst.CreatedAt = src.CreatedAt
+		return
+	}
+	if dst.CreatedAt == nil {
+		dst.CreatedAt = &pb1.Timestamp{}
+	}
+	pb1_Timestamp_ApplyAll(dst.CreatedAt, src.CreatedAt, opts)
+}
+
+func pb_Product_Apply_Quantity(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if !opts.MergeMessages || src.Quantity == nil {
+		dst.Quantity = src.Quantity
+		return
+	}
+	if dst.Quantity == nil {
+		dst.Quantity = &pb1.DoubleValue{}
+	}
+	pb1_DoubleValue_ApplyAll(dst.Quantity, src.Quantity, opts)
+}
+
+func pb_Product_Apply_Stocks(dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Stocks = append(dst.Stocks, src.Stocks...)
+		return
+	}
+	dst.Stocks = src.Stocks
+}
+
+func pb_Product_ApplySub_Provider(m *pb_ProviderInfo_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if dst.Provider == nil && src.Provider == nil {
+		return
+	}
+	if dst.Provider == nil {
+		dst.Provider = &pb.ProviderInfo{}
+	}
+	srcSubMsg := src.Provider
+	if srcSubMsg == nil {
+		srcSubMsg = &pb.ProviderInfo{}
+	}
+	pb_ProviderInfo_Apply(m, dst.Provider, srcSubMsg, opts)
+}
+
+func pb_Product_ApplySub_Attributes(m *pb_Attribute_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Attribute, 0, len(src.Attributes))
+	for _, e := range src.Attributes {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Attribute{}
+		pb_Attribute_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Attributes = append(dst.Attributes, msgList...)
+		return
+	}
+	dst.Attributes = msgList
+}
+
+func pb_Product_ApplySub_CreatedAt(m *pb1_Timestamp_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if dst.CreatedAt == nil && src.CreatedAt == nil {
+		return
+	}
+	if dst.CreatedAt == nil {
+		dst.CreatedAt = &pb1.Timestamp{}
+	}
+	srcSubMsg := src.CreatedAt
+	if srcSubMsg == nil {
+		srcSubMsg = &pb1.Timestamp{}
+	}
+	pb1_Timestamp_Apply(m, dst.CreatedAt, srcSubMsg, opts)
+}
+
+func pb_Product_ApplySub_Quantity(m *pb1_DoubleValue_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	if dst.Quantity == nil && src.Quantity == nil {
+		return
+	}
+	if dst.Quantity == nil {
+		dst.Quantity = &pb1.DoubleValue{}
+	}
+	srcSubMsg := src.Quantity
+	if srcSubMsg == nil {
+		srcSubMsg = &pb1.DoubleValue{}
+	}
+	pb1_DoubleValue_Apply(m, dst.Quantity, srcSubMsg, opts)
+}
+
+func pb_Product_ApplySub_Stocks(m *pb1_Int32Value_Mask, dst *pb.Product, src *pb.Product, opts fields.ApplyOptions) {
+	msgList := make([]*pb1.Int32Value, 0, len(src.Stocks))
+	for _, e := range src.Stocks {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb1.Int32Value{}
+		pb1_Int32Value_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Stocks = append(dst.Stocks, msgList...)
+		return
+	}
+	dst.Stocks = msgList
+}
+
+// =========================================
+// ProviderInfo Apply Functions
+// =========================================
+
+func pb_ProviderInfo_Apply_Id(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_ProviderInfo_Apply_Name(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_ProviderInfo_Apply_Logo(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.Logo = src.Logo
+}
+
+func pb_ProviderInfo_Apply_ImageUrl(dst *pb.ProviderInfo, src *pb.ProviderInfo, opts fields.ApplyOptions) {
+	dst.ImageUrl = src.ImageUrl
+}
+
+// =========================================
+// Attribute Apply Functions
+// =========================================
+
+func pb_Attribute_Apply_Id(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb_Attribute_Apply_Code(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+func pb_Attribute_Apply_Name(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+func pb_Attribute_Apply_Options(dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, src.Options...)
+		return
+	}
+	dst.Options = src.Options
+}
+
+func pb_Attribute_ApplySub_Options(m *pb_Option_Mask, dst *pb.Attribute, src *pb.Attribute, opts fields.ApplyOptions) {
+	msgList := make([]*pb.Option, 0, len(src.Options))
+	for _, e := range src.Options {
+		if e == nil {
+			msgList = append(msgList, nil)
+			continue
+		}
+		newSubMsg := &pb.Option{}
+		pb_Option_Keep(m, newSubMsg, e)
+		msgList = append(msgList, newSubMsg)
+	}
+	if opts.AppendRepeated {
+		dst.Options = append(dst.Options, msgList...)
+		return
+	}
+	dst.Options = msgList
+}
+
+// =========================================
+// Option Apply Functions
+// =========================================
+
+func pb_Option_Apply_Code(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	dst.Code = src.Code
+}
+
+func pb_Option_Apply_Name(dst *pb.Option, src *pb.Option, opts fields.ApplyOptions) {
+	dst.Name = src.Name
+}
+
+// =========================================
+// Timestamp Apply Functions
+// =========================================
+
+func pb1_Timestamp_Apply_Seconds(dst *pb1.Timestamp, src *pb1.Timestamp, opts fields.ApplyOptions) {
+	dst.Seconds = src.Seconds
+}
+
+func pb1_Timestamp_Apply_Nanos(dst *pb1.Timestamp, src *pb1.Timestamp, opts fields.ApplyOptions) {
+	dst.Nanos = src.Nanos
+}
+
+// =========================================
+// DoubleValue Apply Functions
+// =========================================
+
+func pb1_DoubleValue_Apply_Value(dst *pb1.DoubleValue, src *pb1.DoubleValue, opts fields.ApplyOptions) {
+	dst.Value = src.Value
+}
+
+// =========================================
+// Int32Value Apply Functions
+// =========================================
+
+func pb1_Int32Value_Apply_Value(dst *pb1.Int32Value, src *pb1.Int32Value, opts fields.ApplyOptions) {
+	dst.Value = src.Value
+}
+
+// =========================================
+// Document Apply Functions
+// =========================================
+
+func pb2_Document_Apply_Id(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	dst.Id = src.Id
+}
+
+func pb2_Document_Apply_Metadata(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	dst.Metadata = src.Metadata
+}
+
+func pb2_Document_Apply_Extra(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	dst.Extra = src.Extra
+}
+
+func pb2_Document_Apply_Tags(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	dst.Tags = src.Tags
+}
+
+func pb2_Document_Apply_History(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	if opts.AppendRepeated {
+		dst.History = append(dst.History, src.History...)
+		return
+	}
+	dst.History = src.History
+}
+
+func pb2_Document_Apply_ToProto(dst *pb2.Document, src *pb2.Document, opts fields.ApplyOptions) {
+	dst.ToProto = src.ToProto
+}
+
+// pb_Product_Paths builds the field paths of the sub fields of Product
+type pb_Product_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Product_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Product_Paths) Sku() fields.Path {
+	return p.path.Append("sku")
+}
+
+func (p pb_Product_Paths) Provider() pb_ProviderInfo_Paths {
+	return pb_ProviderInfo_Paths{path: p.path.Append("provider")}
+}
+
+func (p pb_Product_Paths) Attributes() pb_Attribute_Paths {
+	return pb_Attribute_Paths{path: p.path.Append("attributes")}
+}
+
+func (p pb_Product_Paths) SellerIds() fields.Path {
+	return p.path.Append("sellerIds")
+}
+
+func (p pb_Product_Paths) BrandCodes() fields.Path {
+	return p.path.Append("brandCodes")
+}
+
+func (p pb_Product_Paths) CreatedAt() pb1_Timestamp_Paths {
+	return pb1_Timestamp_Paths{path: p.path.Append("createdAt")}
+}
+
+func (p pb_Product_Paths) Quantity() pb1_DoubleValue_Paths {
+	return pb1_DoubleValue_Paths{path: p.path.Append("quantity")}
+}
+
+func (p pb_Product_Paths) Stocks() pb1_Int32Value_Paths {
+	return pb1_Int32Value_Paths{path: p.path.Append("stocks")}
+}
+
+// pb_ProviderInfo_Paths builds the field paths of the sub fields of ProviderInfo
+type pb_ProviderInfo_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_ProviderInfo_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_ProviderInfo_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_ProviderInfo_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_ProviderInfo_Paths) Logo() fields.Path {
+	return p.path.Append("logo")
+}
+
+func (p pb_ProviderInfo_Paths) ImageUrl() fields.Path {
+	return p.path.Append("imageUrl")
+}
+
+// pb_Attribute_Paths builds the field paths of the sub fields of Attribute
+type pb_Attribute_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Attribute_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Attribute_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb_Attribute_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+func (p pb_Attribute_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+func (p pb_Attribute_Paths) Options() pb_Option_Paths {
+	return pb_Option_Paths{path: p.path.Append("options")}
+}
+
+// pb_Option_Paths builds the field paths of the sub fields of Option
+type pb_Option_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb_Option_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb_Option_Paths) Code() fields.Path {
+	return p.path.Append("code")
+}
+
+func (p pb_Option_Paths) Name() fields.Path {
+	return p.path.Append("name")
+}
+
+// pb1_Timestamp_Paths builds the field paths of the sub fields of Timestamp
+type pb1_Timestamp_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb1_Timestamp_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb1_Timestamp_Paths) Seconds() fields.Path {
+	return p.path.Append("seconds")
+}
+
+func (p pb1_Timestamp_Paths) Nanos() fields.Path {
+	return p.path.Append("nanos")
+}
+
+// pb1_DoubleValue_Paths builds the field paths of the sub fields of DoubleValue
+type pb1_DoubleValue_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb1_DoubleValue_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb1_DoubleValue_Paths) Value() fields.Path {
+	return p.path.Append("value")
+}
+
+// pb1_Int32Value_Paths builds the field paths of the sub fields of Int32Value
+type pb1_Int32Value_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb1_Int32Value_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb1_Int32Value_Paths) Value() fields.Path {
+	return p.path.Append("value")
+}
+
+// pb2_Document_Paths builds the field paths of the sub fields of Document
+type pb2_Document_Paths struct {
+	path fields.Path
+}
+
+// Path returns the path of the whole message
+func (p pb2_Document_Paths) Path() fields.Path {
+	return p.path
+}
+
+func (p pb2_Document_Paths) Id() fields.Path {
+	return p.path.Append("id")
+}
+
+func (p pb2_Document_Paths) Metadata() fields.Path {
+	return p.path.Append("metadata")
+}
+
+func (p pb2_Document_Paths) Extra() fields.Path {
+	return p.path.Append("extra")
+}
+
+func (p pb2_Document_Paths) Tags() fields.Path {
+	return p.path.Append("tags")
+}
+
+func (p pb2_Document_Paths) History() fields.Path {
+	return p.path.Append("history")
+}
+
+func (p pb2_Document_Paths) ToProto() fields.Path {
+	return p.path.Append("toProto")
+}
+
+// pb_Product_Selection queries whether the fields of Product are selected
+type pb_Product_Selection struct {
+	selected bool
+	mask     *pb_Product_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Product_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Product_Selection) Sku() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Product_Selection) Provider() pb_ProviderInfo_Selection {
+	if s.mask == nil {
+		return pb_ProviderInfo_Selection{selected: s.selected}
+	}
+	return pb_ProviderInfo_Selection{selected: s.mask.bits[0]&(1<<1) != 0, mask: s.mask.Provider}
+}
+
+func (s pb_Product_Selection) Attributes() pb_Attribute_Selection {
+	if s.mask == nil {
+		return pb_Attribute_Selection{selected: s.selected}
+	}
+	return pb_Attribute_Selection{selected: s.mask.bits[0]&(1<<2) != 0, mask: s.mask.Attributes}
+}
+
+func (s pb_Product_Selection) SellerIds() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func (s pb_Product_Selection) BrandCodes() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<4) != 0)
+}
+
+func (s pb_Product_Selection) CreatedAt() pb1_Timestamp_Selection {
+	if s.mask == nil {
+		return pb1_Timestamp_Selection{selected: s.selected}
+	}
+	return pb1_Timestamp_Selection{selected: s.mask.bits[0]&(1<<5) != 0, mask: s.mask.CreatedAt}
+}
+
+func (s pb_Product_Selection) Quantity() pb1_DoubleValue_Selection {
+	if s.mask == nil {
+		return pb1_DoubleValue_Selection{selected: s.selected}
+	}
+	return pb1_DoubleValue_Selection{selected: s.mask.bits[0]&(1<<6) != 0, mask: s.mask.Quantity}
+}
+
+func (s pb_Product_Selection) Stocks() pb1_Int32Value_Selection {
+	if s.mask == nil {
+		return pb1_Int32Value_Selection{selected: s.selected}
+	}
+	return pb1_Int32Value_Selection{selected: s.mask.bits[0]&(1<<7) != 0, mask: s.mask.Stocks}
+}
+
+func pb_Product_HasPath(m *pb_Product_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "sku":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "provider":
+		if m == nil {
+			return len(path) == 1 || pb_ProviderInfo_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<1) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Provider == nil
+		}
+		return pb_ProviderInfo_HasPath(m.Provider, path[1:], partial)
+	case "attributes":
+		if m == nil {
+			return len(path) == 1 || pb_Attribute_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Attributes == nil
+		}
+		return pb_Attribute_HasPath(m.Attributes, path[1:], partial)
+	case "sellerIds", "seller_ids":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	case "brandCodes", "brand_codes":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<4) != 0)
+	case "createdAt", "created_at":
+		if m == nil {
+			return len(path) == 1 || pb1_Timestamp_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<5) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.CreatedAt == nil
+		}
+		return pb1_Timestamp_HasPath(m.CreatedAt, path[1:], partial)
+	case "quantity":
+		if m == nil {
+			return len(path) == 1 || pb1_DoubleValue_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<6) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Quantity == nil
+		}
+		return pb1_DoubleValue_HasPath(m.Quantity, path[1:], partial)
+	case "stocks":
+		if m == nil {
+			return len(path) == 1 || pb1_Int32Value_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<7) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Stocks == nil
+		}
+		return pb1_Int32Value_HasPath(m.Stocks, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_ProviderInfo_Selection queries whether the fields of ProviderInfo are selected
+type pb_ProviderInfo_Selection struct {
+	selected bool
+	mask     *pb_ProviderInfo_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_ProviderInfo_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_ProviderInfo_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) Logo() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb_ProviderInfo_Selection) ImageUrl() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func pb_ProviderInfo_HasPath(m *pb_ProviderInfo_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "logo":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<2) != 0)
+	case "imageUrl", "image_url":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<3) != 0)
+	default:
+		return false
+	}
+}
+
+// pb_Attribute_Selection queries whether the fields of Attribute are selected
+type pb_Attribute_Selection struct {
+	selected bool
+	mask     *pb_Attribute_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Attribute_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Attribute_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Attribute_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb_Attribute_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb_Attribute_Selection) Options() pb_Option_Selection {
+	if s.mask == nil {
+		return pb_Option_Selection{selected: s.selected}
+	}
+	return pb_Option_Selection{selected: s.mask.bits[0]&(1<<3) != 0, mask: s.mask.Options}
+}
+
+func pb_Attribute_HasPath(m *pb_Attribute_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<2) != 0)
+	case "options":
+		if m == nil {
+			return len(path) == 1 || pb_Option_HasPath(nil, path[1:], partial)
+		}
+		if m.bits[0]&(1<<3) == 0 {
+			return false
+		}
+		if len(path) == 1 {
+			return partial || m.Options == nil
+		}
+		return pb_Option_HasPath(m.Options, path[1:], partial)
+	default:
+		return false
+	}
+}
+
+// pb_Option_Selection queries whether the fields of Option are selected
+type pb_Option_Selection struct {
+	selected bool
+	mask     *pb_Option_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb_Option_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb_Option_Selection) Code() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb_Option_Selection) Name() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func pb_Option_HasPath(m *pb_Option_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "code":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "name":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	default:
+		return false
+	}
+}
+
+// pb1_Timestamp_Selection queries whether the fields of Timestamp are selected
+type pb1_Timestamp_Selection struct {
+	selected bool
+	mask     *pb1_Timestamp_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb1_Timestamp_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb1_Timestamp_Selection) Seconds() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb1_Timestamp_Selection) Nanos() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func pb1_Timestamp_HasPath(m *pb1_Timestamp_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "seconds":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "nanos":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<1) != 0)
+	default:
+		return false
+	}
+}
+
+// pb1_DoubleValue_Selection queries whether the fields of DoubleValue are selected
+type pb1_DoubleValue_Selection struct {
+	selected bool
+	mask     *pb1_DoubleValue_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb1_DoubleValue_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb1_DoubleValue_Selection) Value() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func pb1_DoubleValue_HasPath(m *pb1_DoubleValue_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "value":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	default:
+		return false
+	}
+}
+
+// pb1_Int32Value_Selection queries whether the fields of Int32Value are selected
+type pb1_Int32Value_Selection struct {
+	selected bool
+	mask     *pb1_Int32Value_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb1_Int32Value_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb1_Int32Value_Selection) Value() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func pb1_Int32Value_HasPath(m *pb1_Int32Value_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "value":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	default:
+		return false
+	}
+}
+
+// pb2_Document_Selection queries whether the fields of Document are selected
+type pb2_Document_Selection struct {
+	selected bool
+	mask     *pb2_Document_Mask
+}
+
+// Selected checks whether the message, or any of its fields, is selected
+func (s pb2_Document_Selection) Selected() bool {
+	return s.selected
+}
+
+func (s pb2_Document_Selection) Id() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<0) != 0)
+}
+
+func (s pb2_Document_Selection) Metadata() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<1) != 0)
+}
+
+func (s pb2_Document_Selection) Extra() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<2) != 0)
+}
+
+func (s pb2_Document_Selection) Tags() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<3) != 0)
+}
+
+func (s pb2_Document_Selection) History() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<4) != 0)
+}
+
+func (s pb2_Document_Selection) ToProto() bool {
+	return s.selected && (s.mask == nil || s.mask.bits[0]&(1<<5) != 0)
+}
+
+func pb2_Document_HasPath(m *pb2_Document_Mask, path []string, partial bool) bool {
+	switch path[0] {
+	case "id":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<0) != 0)
+	case "metadata":
+		if m == nil {
+			return true
+		}
+		if m.bits[0]&(1<<1) == 0 {
+			return false
+		}
+		if m.Metadata == nil || fields.HasWildcard(m.Metadata) {
+			return true
+		}
+		if len(path) == 1 {
+			return partial
+		}
+		subFields, ok := fields.SubTree(m.Metadata, strings.Join(path[1:], "."))
+		return ok && (partial || len(subFields) == 0)
+	case "extra":
+		if m == nil {
+			return true
+		}
+		if m.bits[0]&(1<<2) == 0 {
+			return false
+		}
+		if m.Extra == nil || fields.HasWildcard(m.Extra) {
+			return true
+		}
+		if len(path) == 1 {
+			return partial
+		}
+		subFields, ok := fields.SubTree(m.Extra, strings.Join(path[1:], "."))
+		return ok && (partial || len(subFields) == 0)
+	case "tags":
+		if m == nil {
+			return true
+		}
+		if m.bits[0]&(1<<3) == 0 {
+			return false
+		}
+		if m.Tags == nil || fields.HasWildcard(m.Tags) {
+			return true
+		}
+		if len(path) == 1 {
+			return partial
+		}
+		subFields, ok := fields.SubTree(m.Tags, strings.Join(path[1:], "."))
+		return ok && (partial || len(subFields) == 0)
+	case "history":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<4) != 0)
+	case "toProto", "to_proto":
+		return len(path) == 1 && (m == nil || m.bits[0]&(1<<5) != 0)
+	default:
+		return false
+	}
+}
+
+// pb1_Struct_KeepKeys keeps only the keys of msg in keys, applying the sub fields of the keys to the values.
+// An empty list of keys or the wildcard means all keys
+func pb1_Struct_KeepKeys(keys []fields.FieldInfo, msg *pb1.Struct) *pb1.Struct {
+	if len(keys) == 0 || fields.HasWildcard(keys) || msg == nil {
+		return msg
+	}
+	newMsg := &pb1.Struct{Fields: make(map[string]*pb1.Value, len(keys))}
+	for _, key := range keys {
+		if value, ok := msg.Fields[key.FieldName]; ok {
+			newMsg.Fields[key.FieldName] = pb1_Value_KeepKeys(key.SubFields, value)
+		}
+	}
+	return newMsg
+}
+
+// pb1_Value_KeepKeys keeps only the keys of a struct value, or of the struct values of a list value.
+// Other values are kept as a whole
+func pb1_Value_KeepKeys(keys []fields.FieldInfo, msg *pb1.Value) *pb1.Value {
+	if len(keys) == 0 || fields.HasWildcard(keys) {
+		return msg
+	}
+	switch kind := msg.GetKind().(type) {
+	case *pb1.Value_StructValue:
+		return &pb1.Value{Kind: &pb1.Value_StructValue{StructValue: pb1_Struct_KeepKeys(keys, kind.StructValue)}}
+	case *pb1.Value_ListValue:
+		return &pb1.Value{Kind: &pb1.Value_ListValue{ListValue: pb1_ListValue_KeepKeys(keys, kind.ListValue)}}
+	default:
+		return msg
+	}
+}
+
+// pb1_ListValue_KeepKeys keeps only the keys of the struct values of msg
+func pb1_ListValue_KeepKeys(keys []fields.FieldInfo, msg *pb1.ListValue) *pb1.ListValue {
+	if len(keys) == 0 || fields.HasWildcard(keys) || msg == nil {
+		return msg
+	}
+	values := make([]*pb1.Value, 0, len(msg.Values))
+	for _, value := range msg.Values {
+		values = append(values, pb1_Value_KeepKeys(keys, value))
+	}
+	return &pb1.ListValue{Values: values}
+}
+
+// pb1_Struct_ApplyKeys updates the keys of dst from src, deleting the keys that are not in src,
+// and returns the updated struct
+func pb1_Struct_ApplyKeys(keys []fields.FieldInfo, dst *pb1.Struct, src *pb1.Struct) *pb1.Struct {
+	if len(keys) == 0 || fields.HasWildcard(keys) {
+		return src
+	}
+	if dst == nil && src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = &pb1.Struct{}
+	}
+	if dst.Fields == nil {
+		dst.Fields = make(map[string]*pb1.Value, len(keys))
+	}
+	for _, key := range keys {
+		value, ok := src.GetFields()[key.FieldName]
+		if !ok {
+			delete(dst.Fields, key.FieldName)
+			continue
+		}
+		dst.Fields[key.FieldName] = pb1_Value_ApplyKeys(key.SubFields, dst.Fields[key.FieldName], value)
+	}
+	return dst
+}
+
+// pb1_Value_ApplyKeys updates the keys of dst from src when both are struct values,
+// otherwise replaces dst by the kept keys of src
+func pb1_Value_ApplyKeys(keys []fields.FieldInfo, dst *pb1.Value, src *pb1.Value) *pb1.Value {
+	dstStruct, srcStruct := dst.GetStructValue(), src.GetStructValue()
+	if len(keys) == 0 || fields.HasWildcard(keys) || dstStruct == nil || srcStruct == nil {
+		return pb1_Value_KeepKeys(keys, src)
+	}
+	pb1_Struct_ApplyKeys(keys, dstStruct, srcStruct)
+	return dst
+}
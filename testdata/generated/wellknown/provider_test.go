@@ -0,0 +1,239 @@
+package wellknown
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/QuangTung97/fieldmask/fields"
+	"github.com/QuangTung97/fieldmask/testdata/document"
+	"github.com/QuangTung97/fieldmask/testdata/pb"
+)
+
+func TestProductFieldMask_Well_Known_Types(t *testing.T) {
+	product := &pb.Product{
+		Sku:       "SKU01",
+		CreatedAt: &types.Timestamp{Seconds: 1000, Nanos: 20},
+		Quantity:  &types.DoubleValue{Value: 886},
+		Stocks: []*types.Int32Value{
+			nil,
+			{Value: 228},
+		},
+	}
+
+	t.Run("sub fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"createdAt.seconds", "quantity.value", "stocks.value"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			CreatedAt: &types.Timestamp{Seconds: 1000},
+			Quantity:  &types.DoubleValue{Value: 886},
+			Stocks: []*types.Int32Value{
+				nil,
+				{Value: 228},
+			},
+		}, fm.Mask(product))
+	})
+
+	t.Run("whole fields", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"sku", "createdAt"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &pb.Product{
+			Sku:       "SKU01",
+			CreatedAt: &types.Timestamp{Seconds: 1000, Nanos: 20},
+		}, fm.Mask(product))
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"createdAt.nanos"})
+		assert.Equal(t, nil, err)
+
+		dst := &pb.Product{
+			Sku:       "SKU02",
+			CreatedAt: &types.Timestamp{Seconds: 2000, Nanos: 30},
+		}
+		fm.Apply(dst, product)
+		assert.Equal(t, &pb.Product{
+			Sku:       "SKU02",
+			CreatedAt: &types.Timestamp{Seconds: 2000, Nanos: 20},
+		}, dst)
+	})
+
+	t.Run("not found sub field", func(t *testing.T) {
+		fm, err := NewProductFieldMask([]string{"createdAt.value"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("value"), "createdAt"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func structValue(fieldMap map[string]*types.Value) *types.Value {
+	return &types.Value{Kind: &types.Value_StructValue{StructValue: &types.Struct{Fields: fieldMap}}}
+}
+
+func stringValue(s string) *types.Value {
+	return &types.Value{Kind: &types.Value_StringValue{StringValue: s}}
+}
+
+func newDocument() *document.Document {
+	return &document.Document{
+		Id: "DOC01",
+		Metadata: &types.Struct{Fields: map[string]*types.Value{
+			"color": stringValue("red"),
+			"size":  stringValue("XL"),
+			"address": structValue(map[string]*types.Value{
+				"city":   stringValue("Hanoi"),
+				"street": stringValue("Street 01"),
+			}),
+		}},
+		Extra: structValue(map[string]*types.Value{
+			"note":  stringValue("Note 01"),
+			"owner": stringValue("Owner 01"),
+		}),
+		Tags: &types.ListValue{Values: []*types.Value{
+			structValue(map[string]*types.Value{
+				"code": stringValue("TAG01"),
+				"name": stringValue("Tag 01"),
+			}),
+			stringValue("TAG02"),
+		}},
+		History: []*types.Struct{
+			{Fields: map[string]*types.Value{"color": stringValue("blue")}},
+		},
+	}
+}
+
+func TestDocumentFieldMask_Struct_Keys(t *testing.T) {
+	doc := newDocument()
+
+	t.Run("keys", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"metadata.{color|address.city}", "extra.note", "tags.code"})
+		assert.Equal(t, nil, err)
+
+		expected := &document.Document{
+			Metadata: &types.Struct{Fields: map[string]*types.Value{
+				"color":   stringValue("red"),
+				"address": structValue(map[string]*types.Value{"city": stringValue("Hanoi")}),
+			}},
+			Extra: structValue(map[string]*types.Value{"note": stringValue("Note 01")}),
+			Tags: &types.ListValue{Values: []*types.Value{
+				structValue(map[string]*types.Value{"code": stringValue("TAG01")}),
+				stringValue("TAG02"),
+			}},
+		}
+		assert.Equal(t, expected, fm.Mask(doc))
+
+		dst := newDocument()
+		fm.MaskInPlace(dst)
+		assert.Equal(t, expected, dst)
+
+		assert.Equal(t, newDocument(), doc)
+	})
+
+	t.Run("whole fields", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"id", "metadata", "extra.*"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, &document.Document{
+			Id:       "DOC01",
+			Metadata: doc.Metadata,
+			Extra:    doc.Extra,
+		}, fm.Mask(doc))
+	})
+
+	t.Run("not found keys", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"metadata.unknown", "extra.note.value"})
+		assert.Equal(t, nil, err)
+
+		// keys of non-struct values are kept as a whole
+		assert.Equal(t, &document.Document{
+			Metadata: &types.Struct{Fields: map[string]*types.Value{}},
+			Extra:    structValue(map[string]*types.Value{"note": stringValue("Note 01")}),
+		}, fm.Mask(doc))
+	})
+
+	t.Run("repeated struct is masked as a whole", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"history.color"})
+		assert.Equal(t, fields.PrependParentField(fields.ErrFieldNotFound("color"), "history"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("has", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"metadata.{color|address.city}", "extra"})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, false, fm.Has("metadata"))
+		assert.Equal(t, true, fm.HasAny("metadata"))
+		assert.Equal(t, true, fm.Has("metadata.color"))
+		assert.Equal(t, false, fm.Has("metadata.address"))
+		assert.Equal(t, true, fm.HasAny("metadata.address"))
+		assert.Equal(t, true, fm.Has("metadata.address.city"))
+		assert.Equal(t, false, fm.HasAny("metadata.size"))
+		assert.Equal(t, true, fm.Has("extra.note"))
+		assert.Equal(t, false, fm.HasAny("tags"))
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		fm, err := NewDocumentFieldMask([]string{"metadata.{color|style|address.city}", "extra.note"})
+		assert.Equal(t, nil, err)
+
+		dst := &document.Document{
+			Id: "DOC02",
+			Metadata: &types.Struct{Fields: map[string]*types.Value{
+				"color": stringValue("green"),
+				"style": stringValue("old"),
+				"address": structValue(map[string]*types.Value{
+					"city":    stringValue("Saigon"),
+					"country": stringValue("VN"),
+				}),
+			}},
+			Extra: stringValue("old extra"),
+		}
+		fm.Apply(dst, doc)
+
+		assert.Equal(t, &document.Document{
+			Id: "DOC02",
+			Metadata: &types.Struct{Fields: map[string]*types.Value{
+				"color": stringValue("red"),
+				"address": structValue(map[string]*types.Value{
+					"city":    stringValue("Hanoi"),
+					"country": stringValue("VN"),
+				}),
+			}},
+			Extra: structValue(map[string]*types.Value{"note": stringValue("Note 01")}),
+		}, dst)
+	})
+
+	t.Run("exclude keys", func(t *testing.T) {
+		fm, err := NewDocumentExcludeMask([]string{"metadata.color"})
+		assert.Equal(t, fields.ErrSubtractFromWholeField("metadata"), err)
+		assert.Nil(t, fm)
+	})
+
+	t.Run("limited to keys", func(t *testing.T) {
+		limitedTo := fields.WithLimitedToFields([]string{"metadata.{color|size}"})
+
+		fm, err := NewDocumentFieldMask([]string{"metadata.color"}, limitedTo)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, &document.Document{
+			Metadata: &types.Struct{Fields: map[string]*types.Value{"color": stringValue("red")}},
+		}, fm.Mask(doc))
+
+		fm, err = NewDocumentFieldMask([]string{"metadata.address"}, limitedTo)
+		assert.Equal(t, fields.ErrFieldNotFound("metadata.address"), err)
+		assert.Nil(t, fm)
+	})
+}
+
+func TestDocumentFieldMask_Field_Named_As_Method(t *testing.T) {
+	fm, err := NewDocumentFieldMask([]string{"id", "toProto"})
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, []string{"id", "to_proto"}, fm.ToProto().Paths)
+	assert.Equal(t, true, fm.Selection().ToProto())
+	assert.Equal(t, false, fm.Selection().Extra())
+
+	doc := &document.Document{Id: "DOC01", ToProto: true, Extra: &types.Value{}}
+	assert.Equal(t, &document.Document{Id: "DOC01", ToProto: true}, fm.Mask(doc))
+}
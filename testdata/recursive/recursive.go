@@ -0,0 +1,72 @@
+// Package recursive contains hand-written proto messages of recursive types, equivalent to:
+//
+//	message Category {
+//	  int32 id = 1;
+//	  string name = 2;
+//	  Category parent = 3;
+//	  repeated Category children = 4;
+//	}
+//
+//	message Thread {
+//	  string title = 1;
+//	  repeated Comment comments = 2;
+//	}
+//
+//	message Comment {
+//	  int32 id = 1;
+//	  string content = 2;
+//	  Thread replies = 3;
+//	}
+package recursive
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+// Category ...
+type Category struct {
+	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Parent   *Category   `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Children []*Category `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+// Reset ...
+func (m *Category) Reset() { *m = Category{} }
+
+// String ...
+func (m *Category) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage ...
+func (*Category) ProtoMessage() {}
+
+// Thread ...
+type Thread struct {
+	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Comments []*Comment `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+// Reset ...
+func (m *Thread) Reset() { *m = Thread{} }
+
+// String ...
+func (m *Thread) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage ...
+func (*Thread) ProtoMessage() {}
+
+// Comment ...
+type Comment struct {
+	Id      int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content string  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Replies *Thread `protobuf:"bytes,3,opt,name=replies,proto3" json:"replies,omitempty"`
+}
+
+// Reset ...
+func (m *Comment) Reset() { *m = Comment{} }
+
+// String ...
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage ...
+func (*Comment) ProtoMessage() {}